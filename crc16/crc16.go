@@ -0,0 +1,272 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+// Package crc16 implements the 16-bit cyclic redundancy check, or CRC-16, checksum.
+// See https://en.wikipedia.org/wiki/Cyclic_redundancy_check for information.
+//
+// Polynomials are represented in LSB-first form, also known as reversed representation.
+// Like the crc32 and crc64 packages, sums are inverted before and after they're updated.
+//
+// Checksums are layed out in big-endian byte order.
+package crc16
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"sync"
+
+	"bursavich.dev/crc/internal/lazy"
+)
+
+// The size of a CRC-16 checksum in bytes.
+const Size = 2
+
+// Predefined polynomials in LSB-first form.
+const (
+	CCITTPoly = 0x8408
+	IBMPoly   = 0xa001
+)
+
+var ccittPoly = lazy.Value[*Poly]{
+	Init: func() *Poly {
+		return makePoly(CCITTPoly)
+	},
+}
+
+// CCITT returns the [Poly] representing the CCITT polynomial, x^16 + x^12 + x^5 + 1,
+// which is 0x1021 in normal form. It computes CRC-16/IBM-SDLC, as used by X.25 and HDLC.
+func CCITT() *Poly {
+	return ccittPoly.Get()
+}
+
+var ibmPoly = lazy.Value[*Poly]{
+	Init: func() *Poly {
+		return makePoly(IBMPoly)
+	},
+}
+
+// IBM returns the [Poly] representing the IBM polynomial, x^16 + x^15 + x^2 + 1,
+// which is 0x8005 in normal form and is also known as ANSI. It's used by USB, Modbus, ...
+func IBM() *Poly {
+	return ibmPoly.Get()
+}
+
+// Hash is a [hash.Hash] that also implements [encoding.BinaryMarshaler]
+// and [encoding.BinaryUnmarshaler] to marshal and unmarshal the internal state
+// of the hash. Its Sum method will lay the value out in big-endian byte order.
+type Hash interface {
+	hash.Hash
+	Sum16() uint16
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+// New creates a new [Hash] computing the CRC-16 checksum using the polynomial
+// represented by the [Poly].
+func New(p *Poly) Hash {
+	return &digest{poly: p}
+}
+
+const nBits = Size * 8
+
+// Poly represents a 16-bit polynomial with tables for efficient processing.
+type Poly struct {
+	poly   uint16
+	x2nTbl [nBits]uint16
+	table  [256]uint16
+}
+
+// MakePoly returns a [Poly] constructed from the specified polynomial
+// given in LSB-first form, also known as reversed representation.
+// The returned [Poly] may be shared and must not be modified. Polys are cached
+// for the life of the process, so each polynomial's tables are computed once.
+func MakePoly(poly uint16) *Poly {
+	switch poly {
+	case CCITTPoly:
+		return CCITT()
+	case IBMPoly:
+		return IBM()
+	default:
+		return cachedPoly(poly)
+	}
+}
+
+// polyCache holds the [Poly] of each polynomial that isn't named, once made,
+// so that it's shared like the named ones. It's a map[uint16]*Poly.
+var polyCache sync.Map
+
+func cachedPoly(poly uint16) *Poly {
+	if p, ok := polyCache.Load(poly); ok {
+		return p.(*Poly)
+	}
+	p, _ := polyCache.LoadOrStore(poly, makePoly(poly))
+	return p.(*Poly)
+}
+
+func makePoly(poly uint16) *Poly {
+	p := &Poly{poly: poly}
+	for i := range p.table {
+		crc := uint16(i)
+		for range 8 {
+			if crc&1 == 1 {
+				crc = (crc >> 1) ^ poly
+			} else {
+				crc >>= 1
+			}
+		}
+		p.table[i] = crc
+	}
+	v := uint16(1) << (nBits - 2)
+	p.x2nTbl[0] = v
+	for n := 1; n < nBits; n++ {
+		v = p.multModP(v, v)
+		p.x2nTbl[n] = v
+	}
+	return p
+}
+
+// Polynomial returns the polynomial in LSB-first form, also known as reversed representation.
+func (p *Poly) Polynomial() uint16 {
+	return p.poly
+}
+
+// Checksum returns the CRC-16 checksum of data.
+func (p *Poly) Checksum(data []byte) uint16 {
+	return p.Update(0, data)
+}
+
+// Update returns the result of adding the bytes in data to the sum.
+func (p *Poly) Update(sum uint16, data []byte) uint16 {
+	crc := ^sum
+	for _, v := range data {
+		crc = p.table[byte(crc)^v] ^ (crc >> 8)
+	}
+	return ^crc
+}
+
+// Combine returns the result of adding n bytes with the next sum to the prev sum.
+func (p *Poly) Combine(prev, next uint16, n int64) uint16 {
+	if prev == 0 {
+		return next
+	}
+	if n <= 0 {
+		return prev
+	}
+	return p.multModP(prev, p.x2NModP(n, 3)) ^ next
+}
+
+// ExtendZeros returns the result of adding n zero bytes to the sum, as if by [Poly.Update]
+// with a buffer of n zeros, in time logarithmic in n. It's useful for the holes of sparse
+// files. Note that it differs from combining with a zero next sum, which is the sum of no
+// bytes rather than of n zeros. It returns the sum unchanged if n isn't positive.
+func (p *Poly) ExtendZeros(sum uint16, n int64) uint16 {
+	if n <= 0 {
+		return sum
+	}
+	return ^p.multModP(p.x2NModP(n, 3), ^sum)
+}
+
+// multModP returns (a(x) * b(x)) modulo p(x), where p(x) is the CRC polynomial, reflected.
+// For speed, this requires that a is not zero.
+func (p *Poly) multModP(a, b uint16) uint16 {
+	var v uint16
+	for m := uint16(1) << (nBits - 1); m != 0; m >>= 1 {
+		if a&m != 0 {
+			if v ^= b; a&(m-1) == 0 {
+				return v
+			}
+		}
+		xor := b&1 != 0
+		if b >>= 1; xor {
+			b ^= p.poly
+		}
+	}
+	panic("crc16: invalid state")
+}
+
+// x2NModP returns x^(n * 2^k) modulo p(x).
+func (p *Poly) x2NModP(n int64, k uint32) uint16 {
+	v := uint16(1) << (nBits - 1)
+	var sq uint16
+	for ; n != 0; n, k = n>>1, k+1 {
+		// The table is too short for the powers of x to repeat within it,
+		// so larger powers are found by squaring.
+		if k < nBits {
+			sq = p.x2nTbl[k]
+		} else {
+			sq = p.multModP(sq, sq)
+		}
+		if n&1 != 0 {
+			v = p.multModP(sq, v)
+		}
+	}
+	return v
+}
+
+// The marshaled state is laid out as a magic identifier,
+// the polynomial, and the current sum.
+const (
+	magic         = "crc16\x01"
+	marshaledSize = len(magic) + 2*Size
+)
+
+var (
+	// ErrInvalidState is returned when unmarshaling hash state
+	// that is malformed or truncated.
+	ErrInvalidState = errors.New("crc16: invalid hash state")
+
+	// ErrStateMismatch is returned when unmarshaling hash state
+	// that was marshaled by a [Hash] using a different polynomial.
+	ErrStateMismatch = errors.New("crc16: hash state polynomial mismatch")
+)
+
+type digest struct {
+	poly *Poly
+	crc  uint16
+}
+
+func (d *digest) Size() int { return Size }
+
+func (d *digest) BlockSize() int { return 1 }
+
+func (d *digest) Reset() { d.crc = 0 }
+
+func (d *digest) Write(p []byte) (int, error) {
+	d.crc = d.poly.Update(d.crc, p)
+	return len(p), nil
+}
+
+func (d *digest) Sum16() uint16 { return d.crc }
+
+func (d *digest) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint16(b, d.crc)
+}
+
+func (d *digest) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, marshaledSize)
+	b = append(b, magic...)
+	b = binary.BigEndian.AppendUint16(b, d.poly.poly)
+	b = binary.BigEndian.AppendUint16(b, d.crc)
+	return b, nil
+}
+
+func (d *digest) UnmarshalBinary(b []byte) error {
+	if len(b) < len(magic) || string(b[:len(magic)]) != magic {
+		return ErrInvalidState
+	}
+	if len(b) != marshaledSize {
+		return ErrInvalidState
+	}
+	i := len(magic)
+	if binary.BigEndian.Uint16(b[i:]) != d.poly.poly {
+		return ErrStateMismatch
+	}
+	i += Size
+	d.crc = binary.BigEndian.Uint16(b[i:])
+	return nil
+}
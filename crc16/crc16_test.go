@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc16
+
+import (
+	"bytes"
+	"errors"
+	"math/bits"
+	"sync"
+	"testing"
+
+	"bursavich.dev/crc/internal/tests"
+)
+
+func FuzzPoly(f *testing.F) {
+	tests.FuzzPoly(f, testPoly)
+}
+
+func TestPoly(t *testing.T) {
+	tests.TestPoly(t, testPoly)
+}
+
+func TestWidth(t *testing.T) {
+	p := CCITT()
+	tests.TestWidth(t, tests.Width[uint16]{
+		Bits:        nBits,
+		Size:        Size,
+		Check:       0x906e, // CRC-16/IBM-SDLC
+		Checksum:    p.Checksum,
+		Update:      p.Update,
+		Combine:     p.Combine,
+		ExtendZeros: p.ExtendZeros,
+	})
+}
+
+func TestCheck(t *testing.T) {
+	// Check values are the checksums of "123456789" published in the CRC RevEng catalogue.
+	data := []byte("123456789")
+	tests := []struct {
+		name string
+		got  uint16
+		want uint16
+	}{
+		{"CRC-16/IBM-SDLC", CCITT().Checksum(data), 0x906e},
+		{"CRC-16/USB", IBM().Checksum(data), 0xb4c8},
+		{"CRC-16/MODBUS", ChecksumModbus(data), 0x4b37},
+		{"CRC-16/KERMIT", ^CCITT().Update(^uint16(0), data), 0x2189},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: Checksum(\"123456789\") = 0x%04x; want 0x%04x", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestMakePolyShared(t *testing.T) {
+	tests := []struct {
+		name string
+		poly uint16
+		want *Poly
+	}{
+		{"CCITT", CCITTPoly, CCITT()},
+		{"IBM", IBMPoly, IBM()},
+	}
+	for _, tt := range tests {
+		if got := MakePoly(tt.poly); got != tt.want {
+			t.Errorf("MakePoly(%s) = %p; want %p", tt.name, got, tt.want)
+		}
+	}
+
+	// Other polynomials are cached when first made.
+	made := make([]*Poly, 8)
+	var wg sync.WaitGroup
+	for i := range made {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			made[i] = MakePoly(0x9b1d)
+		}()
+	}
+	wg.Wait()
+	for _, p := range made {
+		if p != made[0] {
+			t.Errorf("MakePoly(0x9b1d) = %p; want %p", p, made[0])
+		}
+	}
+}
+
+var polys = []*Poly{
+	MakePoly(CCITTPoly),
+	MakePoly(IBMPoly),
+	MakePoly(bits.Reverse16(0xc867)), // CRC-16/CDMA2000, which is 0xc867 in normal form
+	MakePoly(bits.Reverse16(CCITTPoly)),
+}
+
+// updateBits is a bitwise reference that adds the bytes in data to the sum.
+func updateBits(p *Poly, sum uint16, data []byte) uint16 {
+	crc := ^sum
+	for _, b := range data {
+		for i := range 8 {
+			crc ^= uint16(b>>i) & 1
+			if crc&1 != 0 {
+				crc = crc>>1 ^ p.poly
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return ^crc
+}
+
+func testPoly(t *testing.T, a, b []byte) {
+	for _, p := range polys {
+		aSum := p.Checksum(a)
+		bSum := p.Checksum(b)
+		want := p.Update(aSum, b)
+		if ref := updateBits(p, updateBits(p, 0, a), b); want != ref {
+			t.Errorf("Poly = 0x%04x; Update() = 0x%04x; want 0x%04x", p.poly, want, ref)
+		}
+
+		h := New(p)
+		h.Write(a)
+		h.Write(b)
+		if got := h.Sum16(); got != want {
+			t.Errorf("Poly = 0x%04x; Hash.Sum16() = 0x%04x; want 0x%04x", p.poly, got, want)
+		}
+
+		if got := p.Combine(aSum, bSum, int64(len(b))); got != want {
+			t.Errorf("Poly = 0x%04x; Combine(0x%04x, 0x%04x, %d) = 0x%04x; want 0x%04x", p.poly, aSum, bSum, len(b), got, want)
+		}
+	}
+}
+
+func TestCombineLong(t *testing.T) {
+	// Lengths whose powers of x lie beyond the table.
+	for _, p := range polys {
+		for _, n := range []int64{1 << 13, 1<<13 + 5, 1 << 20} {
+			zeros := make([]byte, n)
+			sum := p.Checksum([]byte("prefix"))
+			want := p.Update(sum, zeros)
+			if got := p.ExtendZeros(sum, n); got != want {
+				t.Errorf("Poly = 0x%04x; ExtendZeros(0x%04x, %d) = 0x%04x; want 0x%04x", p.poly, sum, n, got, want)
+			}
+			if got := p.Combine(sum, p.Checksum(zeros), n); got != want {
+				t.Errorf("Poly = 0x%04x; Combine(0x%04x, ..., %d) = 0x%04x; want 0x%04x", p.poly, sum, n, got, want)
+			}
+		}
+	}
+}
+
+func TestHashMarshal(t *testing.T) {
+	data := []byte("hello, world")
+	for _, p := range polys {
+		h := New(p)
+		h.Write(data[:5])
+		state, err := h.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Poly = 0x%04x; MarshalBinary() failed: %v", p.poly, err)
+		}
+		resumed := New(p)
+		if err := resumed.UnmarshalBinary(state); err != nil {
+			t.Fatalf("Poly = 0x%04x; UnmarshalBinary() failed: %v", p.poly, err)
+		}
+		resumed.Write(data[5:])
+		if got, want := resumed.Sum16(), p.Checksum(data); got != want {
+			t.Errorf("Poly = 0x%04x; resumed Sum16() = 0x%04x; want 0x%04x", p.poly, got, want)
+		}
+		// The marshaled state ends with the sum in big-endian byte order.
+		final, _ := resumed.MarshalBinary()
+		if got, want := resumed.Sum([]byte("x")), append([]byte("x"), final[len(final)-Size:]...); !bytes.Equal(got, want) {
+			t.Errorf("Poly = 0x%04x; Sum(x) = %x; want %x", p.poly, got, want)
+		}
+	}
+
+	h := New(polys[0])
+	state, _ := h.MarshalBinary()
+	for _, tt := range []struct {
+		name  string
+		hash  Hash
+		state []byte
+		want  error
+	}{
+		{"empty", New(polys[0]), nil, ErrInvalidState},
+		{"identifier", New(polys[0]), append([]byte("bad"), state[3:]...), ErrInvalidState},
+		{"truncated", New(polys[0]), state[:len(state)-1], ErrInvalidState},
+		{"mismatch", New(polys[1]), state, ErrStateMismatch},
+	} {
+		if err := tt.hash.UnmarshalBinary(tt.state); !errors.Is(err, tt.want) {
+			t.Errorf("%s: UnmarshalBinary() error = %v; want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc16
+
+// ChecksumModbus returns the CRC-16 checksum of data as computed by Modbus, which uses
+// the [IBM] polynomial but doesn't invert the final sum. Modbus transmits the checksum
+// in little-endian byte order.
+func ChecksumModbus(data []byte) uint16 {
+	return ^IBM().Checksum(data)
+}
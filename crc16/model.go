@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc16
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+// A Model is a fully parameterized CRC-16 algorithm in the style of Ross Williams'
+// "A Painless Guide to CRC Error Detection Algorithms", as used by the CRC RevEng catalogue.
+// Unlike [Poly], its polynomial is given in normal form, also known as MSB-first form.
+type Model struct {
+	Width  int    // width of the checksum in bits, which must be 16
+	Poly   uint16 // polynomial in normal form
+	Init   uint16 // initial value of the register
+	RefIn  bool   // whether input bytes are processed least significant bit first
+	RefOut bool   // whether the register is reflected before the final XOR
+	XorOut uint16 // value XORed with the register to produce the checksum
+}
+
+// New creates a new [Hash] computing the CRC-16 checksum of the model.
+// It panics if the model's width isn't 16.
+func (m Model) New() Hash {
+	if m.Width != nBits {
+		panic(fmt.Sprintf("crc16: invalid model width %d", m.Width))
+	}
+	d := &modelDigest{
+		model: m,
+		poly:  m.MakePoly(),
+	}
+	d.Reset()
+	return d
+}
+
+// MakePoly returns the [Poly] of the model's polynomial in LSB-first form. It's made by
+// [MakePoly], so it's shared by every model with the same polynomial, whatever their
+// other parameters, and its tables aren't duplicated.
+func (m Model) MakePoly() *Poly {
+	return MakePoly(bits.Reverse16(m.Poly))
+}
+
+// Checksum returns the CRC-16 checksum of data computed by the model.
+// It panics if the model's width isn't 16.
+func (m Model) Checksum(data []byte) uint16 {
+	d := m.New()
+	d.Write(data)
+	return d.Sum16()
+}
+
+// Check returns the checksum of the ASCII string "123456789",
+// which may be compared to the check value of a published model.
+// It panics if the model's width isn't 16.
+func (m Model) Check() uint16 {
+	return m.Checksum([]byte("123456789"))
+}
+
+// The marshaled model state is laid out as a magic identifier,
+// the model's parameters, and the current register.
+const (
+	modelMagic   = "crcm\x01"
+	modelRefIn   = 1 << 0
+	modelRefOut  = 1 << 1
+	modelDataLen = len(modelMagic) + 3*Size + 1
+	modelLen     = modelDataLen + Size
+)
+
+// modelDigest holds the register reflected, so the tables of the reflected
+// polynomial may be used whether or not the input is reflected.
+type modelDigest struct {
+	model Model
+	poly  *Poly
+	crc   uint16
+}
+
+func (d *modelDigest) Size() int { return Size }
+
+func (d *modelDigest) BlockSize() int { return 1 }
+
+func (d *modelDigest) Reset() { d.crc = bits.Reverse16(d.model.Init) }
+
+func (d *modelDigest) Write(b []byte) (int, error) {
+	p := d.poly
+	if d.model.RefIn {
+		// The sum is inverted before and after it's updated.
+		d.crc = ^p.Update(^d.crc, b)
+		return len(b), nil
+	}
+	// Processing a byte MSB-first is the same as processing
+	// its reflection LSB-first.
+	tbl, crc := p.table, d.crc
+	for _, v := range b {
+		crc = tbl[byte(crc)^bits.Reverse8(v)] ^ (crc >> 8)
+	}
+	d.crc = crc
+	return len(b), nil
+}
+
+func (d *modelDigest) Sum16() uint16 {
+	crc := d.crc
+	if !d.model.RefOut {
+		crc = bits.Reverse16(crc)
+	}
+	return crc ^ d.model.XorOut
+}
+
+func (d *modelDigest) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint16(b, d.Sum16())
+}
+
+func (d *modelDigest) appendModel(b []byte) []byte {
+	var flags byte
+	if d.model.RefIn {
+		flags |= modelRefIn
+	}
+	if d.model.RefOut {
+		flags |= modelRefOut
+	}
+	b = append(b, modelMagic...)
+	b = binary.BigEndian.AppendUint16(b, d.model.Poly)
+	b = binary.BigEndian.AppendUint16(b, d.model.Init)
+	b = binary.BigEndian.AppendUint16(b, d.model.XorOut)
+	return append(b, flags)
+}
+
+func (d *modelDigest) MarshalBinary() ([]byte, error) {
+	b := d.appendModel(make([]byte, 0, modelLen))
+	return binary.BigEndian.AppendUint16(b, d.crc), nil
+}
+
+func (d *modelDigest) UnmarshalBinary(b []byte) error {
+	if len(b) < len(modelMagic) || string(b[:len(modelMagic)]) != modelMagic {
+		return fmt.Errorf("%w: unknown identifier", ErrInvalidState)
+	}
+	if len(b) != modelLen {
+		return fmt.Errorf("%w: got %d bytes; want %d", ErrInvalidState, len(b), modelLen)
+	}
+	if string(b[:modelDataLen]) != string(d.appendModel(make([]byte, 0, modelDataLen))) {
+		return ErrStateMismatch
+	}
+	d.crc = binary.BigEndian.Uint16(b[modelDataLen:])
+	return nil
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc16
+
+import (
+	"errors"
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+func TestModelCheck(t *testing.T) {
+	// Check values are published in the CRC RevEng catalogue.
+	tests := []struct {
+		name  string
+		model Model
+		want  uint16
+	}{
+		{"CRC-16/XMODEM", Model{Width: nBits, Poly: 0x1021, Init: 0x0000, RefIn: false, RefOut: false, XorOut: 0x0000}, 0x31c3},
+		{"CRC-16/ARC", Model{Width: nBits, Poly: 0x8005, Init: 0x0000, RefIn: true, RefOut: true, XorOut: 0x0000}, 0xbb3d},
+		{"CRC-16/MODBUS", Model{Width: nBits, Poly: 0x8005, Init: 0xffff, RefIn: true, RefOut: true, XorOut: 0x0000}, 0x4b37},
+		{"CRC-16/IBM-3740", Model{Width: nBits, Poly: 0x1021, Init: 0xffff, RefIn: false, RefOut: false, XorOut: 0x0000}, 0x29b1},
+		{"CRC-16/GENIBUS", Model{Width: nBits, Poly: 0x1021, Init: 0xffff, RefIn: false, RefOut: false, XorOut: 0xffff}, 0xd64e},
+		{"CRC-16/KERMIT", Model{Width: nBits, Poly: 0x1021, Init: 0x0000, RefIn: true, RefOut: true, XorOut: 0x0000}, 0x2189},
+	}
+	for _, tt := range tests {
+		if got := tt.model.Check(); got != tt.want {
+			t.Errorf("%s: Check() = 0x%04x; want 0x%04x", tt.name, got, tt.want)
+		}
+	}
+}
+
+// modelBits is a bitwise reference that follows the model's definition directly.
+func modelBits(m Model, data []byte) uint16 {
+	crc := m.Init
+	for _, v := range data {
+		if m.RefIn {
+			v = bits.Reverse8(v)
+		}
+		crc ^= uint16(v) << (nBits - 8)
+		for range 8 {
+			if crc&(uint16(1)<<(nBits-1)) != 0 {
+				crc = crc<<1 ^ m.Poly
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	if m.RefOut {
+		crc = bits.Reverse16(crc)
+	}
+	return crc ^ m.XorOut
+}
+
+func TestModel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	data := make([]byte, 300)
+	r.Read(data)
+	for range 32 {
+		m := Model{
+			Width:  nBits,
+			Poly:   uint16(r.Uint64()) | 1,
+			Init:   uint16(r.Uint64()),
+			RefIn:  r.Intn(2) == 0,
+			RefOut: r.Intn(2) == 0,
+			XorOut: uint16(r.Uint64()),
+		}
+		want := modelBits(m, data)
+		if got := m.Checksum(data); got != want {
+			t.Errorf("Model = %+v; Checksum() = 0x%04x; want 0x%04x", m, got, want)
+		}
+
+		h := m.New()
+		h.Write(data[:100])
+		state, err := h.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Model = %+v; MarshalBinary() failed: %v", m, err)
+		}
+		resumed := m.New()
+		if err := resumed.UnmarshalBinary(state); err != nil {
+			t.Fatalf("Model = %+v; UnmarshalBinary() failed: %v", m, err)
+		}
+		resumed.Write(data[100:])
+		if got := resumed.Sum16(); got != want {
+			t.Errorf("Model = %+v; resumed Sum16() = 0x%04x; want 0x%04x", m, got, want)
+		}
+
+		other := m
+		other.RefOut = !other.RefOut
+		if err := other.New().UnmarshalBinary(state); !errors.Is(err, ErrStateMismatch) {
+			t.Errorf("Model = %+v; UnmarshalBinary() of another model's state error = %v; want %v", m, err, ErrStateMismatch)
+		}
+		if err := m.New().UnmarshalBinary(state[:len(state)-1]); !errors.Is(err, ErrInvalidState) {
+			t.Errorf("Model = %+v; UnmarshalBinary() of truncated state error = %v; want %v", m, err, ErrInvalidState)
+		}
+	}
+}
+
+func TestModelMakePoly(t *testing.T) {
+	for _, poly := range []uint16{0x1021, 0x8005} {
+		a := Model{Width: nBits, Poly: poly, RefIn: true, RefOut: true}
+		b := Model{Width: nBits, Poly: poly, Init: ^uint16(0), XorOut: ^uint16(0)}
+		if a.MakePoly() != b.MakePoly() {
+			t.Errorf("Poly = 0x%04x; models don't share a Poly", poly)
+		}
+		if got, want := a.MakePoly().poly, bits.Reverse16(poly); got != want {
+			t.Errorf("Poly = 0x%04x; MakePoly().poly = 0x%04x; want 0x%04x", poly, got, want)
+		}
+		for _, m := range []Model{a, b} {
+			if m.New().(*modelDigest).poly != a.MakePoly() {
+				t.Errorf("Model = %+v; New() doesn't use the shared Poly", m)
+			}
+		}
+	}
+}
+
+func TestModelWidth(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Model{}.New() didn't panic with an invalid width")
+		}
+	}()
+	Model{}.New()
+}
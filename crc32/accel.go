@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+// HardwareAccelerated reports whether the standard library computes checksums for the
+// polynomial using the CPU's CRC or carry-less multiplication instructions instead of
+// tables. Only the IEEE and Castagnoli polynomials are accelerated, and only on amd64,
+// arm64, ppc64le, and s390x CPUs that have the required instructions. It conservatively
+// reports false on other platforms, such as loong64, whose features can't be detected.
+func (p *Poly) HardwareAccelerated() bool {
+	return archAccelerated(p.poly)
+}
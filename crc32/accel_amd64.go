@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"hash/crc32"
+
+	"golang.org/x/sys/cpu"
+)
+
+func archAccelerated(poly uint32) bool {
+	switch poly {
+	case crc32.IEEE:
+		return cpu.X86.HasPCLMULQDQ && cpu.X86.HasSSE41
+	case crc32.Castagnoli:
+		return cpu.X86.HasSSE42
+	default:
+		return false
+	}
+}
@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"hash/crc32"
+
+	"golang.org/x/sys/cpu"
+)
+
+func archAccelerated(poly uint32) bool {
+	return (poly == crc32.IEEE || poly == crc32.Castagnoli) && cpu.ARM64.HasCRC32
+}
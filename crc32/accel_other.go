@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+//go:build !(amd64 || arm64 || ppc64le || s390x)
+
+package crc32
+
+func archAccelerated(poly uint32) bool {
+	return false
+}
@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import "hash/crc32"
+
+func archAccelerated(poly uint32) bool {
+	// The vector instructions are always available on supported CPUs.
+	return poly == crc32.IEEE || poly == crc32.Castagnoli
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import "testing"
+
+func TestHardwareAccelerated(t *testing.T) {
+	// Only IEEE and Castagnoli may be accelerated.
+	for _, p := range polys {
+		if p == IEEE() || p == Castagnoli() {
+			t.Logf("%v.HardwareAccelerated() = %v", p, p.HardwareAccelerated())
+			continue
+		}
+		if p.HardwareAccelerated() {
+			t.Errorf("%v.HardwareAccelerated() = true; want false", p)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"cmp"
+	"slices"
+)
+
+// An Accumulator tracks the CRC-32 checksum and length of a growing sequence
+// of bytes, such as an append-only buffer, so the pair can later be passed to
+// [Poly.Combine]. It must be created by [Poly.NewAccumulator].
+type Accumulator struct {
+	poly  *Poly
+	sum   uint32
+	n     int64
+	marks []accMark
+}
+
+type accMark struct {
+	n   int64
+	sum uint32
+}
+
+// NewAccumulator returns a new [Accumulator] computing the CRC-32 checksum
+// using the polynomial represented by the [Poly].
+func (p *Poly) NewAccumulator() *Accumulator {
+	return &Accumulator{poly: p}
+}
+
+// Append adds the bytes in data to the checksum.
+func (a *Accumulator) Append(data []byte) {
+	a.sum = a.poly.Update(a.sum, data)
+	a.n += int64(len(data))
+}
+
+// Sum32 returns the checksum of all of the bytes appended.
+func (a *Accumulator) Sum32() uint32 {
+	return a.sum
+}
+
+// Len returns the number of bytes appended.
+func (a *Accumulator) Len() int64 {
+	return a.n
+}
+
+// Mark records a checkpoint of the current checksum and length, which may later
+// be restored by [Accumulator.Rollback], and returns its length as the mark.
+func (a *Accumulator) Mark() int64 {
+	if k := len(a.marks); k == 0 || a.marks[k-1].n != a.n {
+		a.marks = append(a.marks, accMark{a.n, a.sum})
+	}
+	return a.n
+}
+
+// Rollback restores the checksum and length recorded by [Accumulator.Mark] for the mark,
+// without rehashing, and discards any later marks. It panics if the mark is unknown
+// or was discarded by an earlier rollback or release.
+func (a *Accumulator) Rollback(mark int64) {
+	i, ok := slices.BinarySearchFunc(a.marks, mark, func(c accMark, n int64) int {
+		return cmp.Compare(c.n, n)
+	})
+	if !ok {
+		panic("crc32: unknown accumulator mark")
+	}
+	a.n, a.sum = a.marks[i].n, a.marks[i].sum
+	a.marks = a.marks[:i+1]
+}
+
+// Release discards the mark and any earlier marks, which may no longer be restored
+// by [Accumulator.Rollback], such as once the bytes up to the mark are committed.
+// Marks are kept until they're rolled back past or released, so a long-lived
+// accumulator should release the marks it no longer needs.
+func (a *Accumulator) Release(mark int64) {
+	i, _ := slices.BinarySearchFunc(a.marks, mark, func(c accMark, n int64) int {
+		return cmp.Compare(c.n, n+1)
+	})
+	a.marks = slices.Delete(a.marks, 0, i)
+}
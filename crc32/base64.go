@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import "encoding/base64"
+
+// ChecksumBase64 returns the CRC-32 checksum of the base64 encoding of data
+// using enc, for protocols that checksum the encoded form rather than the raw bytes.
+// The encoding is hashed as it's produced, without being buffered.
+func (p *Poly) ChecksumBase64(enc *base64.Encoding, data []byte) uint32 {
+	h := p.Hasher()
+	w := base64.NewEncoder(enc, &h)
+	w.Write(data) // Hasher never fails
+	w.Close()
+	return h.Sum32()
+}
+
+// VerifyBase64 reports whether the CRC-32 checksum of the base64 encoding
+// of data using enc matches want.
+func (p *Poly) VerifyBase64(enc *base64.Encoding, data []byte, want uint32) bool {
+	return p.ChecksumBase64(enc, data) == want
+}
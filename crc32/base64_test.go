@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestChecksumBase64(t *testing.T) {
+	encs := []*base64.Encoding{
+		base64.StdEncoding,
+		base64.RawStdEncoding,
+		base64.URLEncoding,
+		base64.RawURLEncoding,
+	}
+	for _, p := range polys {
+		for _, data := range [][]byte{nil, []byte("a"), []byte("ab"), []byte("abc"), randData(1000)} {
+			for _, enc := range encs {
+				want := p.Checksum([]byte(enc.EncodeToString(data)))
+				if got := p.ChecksumBase64(enc, data); got != want {
+					t.Errorf("Poly = 0x%08x; ChecksumBase64(%d bytes) = 0x%08x; want 0x%08x", p.poly, len(data), got, want)
+				}
+				if !p.VerifyBase64(enc, data, want) {
+					t.Errorf("Poly = 0x%08x; VerifyBase64(%d bytes, 0x%08x) = false; want true", p.poly, len(data), want)
+				}
+				if p.VerifyBase64(enc, data, ^want) {
+					t.Errorf("Poly = 0x%08x; VerifyBase64(%d bytes, 0x%08x) = true; want false", p.poly, len(data), ^want)
+				}
+			}
+		}
+	}
+}
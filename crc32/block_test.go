@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBlockChecksums(t *testing.T) {
+	data := randData(1000)
+	for _, p := range polys {
+		for _, size := range []int{1, 100, 333, 1000, 2000} {
+			var want []uint32
+			for off := 0; off < len(data); off += size {
+				want = append(want, p.Checksum(data[off:min(off+size, len(data))]))
+			}
+			if got := p.BlockChecksums(data, size); !slices.Equal(got, want) {
+				t.Errorf("Poly = 0x%08x; BlockChecksums(data, %d) = %x; want %x", p.poly, size, got, want)
+			}
+		}
+		if got := p.BlockChecksums(data, 0); got != nil {
+			t.Errorf("Poly = 0x%08x; BlockChecksums(data, 0) = %x; want nil", p.poly, got)
+		}
+	}
+}
+
+func TestRollingMatch(t *testing.T) {
+	const blockSize = 64
+	data := randData(1000) // 15 full blocks and a partial block of 40 bytes
+	// Degenerate polynomials may collide on distinct blocks.
+	for _, p := range []*Poly{IEEE(), Castagnoli(), Koopman()} {
+		blockSums := make(map[uint32]int)
+		for i, sum := range p.BlockChecksums(data, blockSize) {
+			blockSums[sum] = i
+		}
+
+		var want []Match
+		for i := range 16 {
+			want = append(want, Match{Offset: int64(i * blockSize), Block: i})
+		}
+		if got := p.RollingMatch(data, blockSums, blockSize); !slices.Equal(got, want) {
+			t.Errorf("Poly = 0x%08x; RollingMatch(identical) = %v; want %v", p.poly, got, want)
+		}
+
+		// Insert 10 bytes into the third block and drop the sixth block.
+		target := slices.Concat(data[:150], []byte("0123456789"), data[150:5*blockSize], data[6*blockSize:])
+		want = []Match{{0, 0}, {64, 1}, {202, 3}, {266, 4}}
+		for i := 6; i < 16; i++ {
+			want = append(want, Match{Offset: int64(10 + (i-1)*blockSize), Block: i})
+		}
+		if got := p.RollingMatch(target, blockSums, blockSize); !slices.Equal(got, want) {
+			t.Errorf("Poly = 0x%08x; RollingMatch(edited) = %v; want %v", p.poly, got, want)
+		}
+
+		// Modify the first block, so that the partial block follows unmatched data.
+		target = slices.Concat([]byte("0123456789"), data[15*blockSize:])
+		want = []Match{{Offset: 10, Block: 15}}
+		if got := p.RollingMatch(target, blockSums, blockSize); !slices.Equal(got, want) {
+			t.Errorf("Poly = 0x%08x; RollingMatch(modified prefix) = %v; want %v", p.poly, got, want)
+		}
+		target = slices.Concat(data[:blockSize], make([]byte, 2*blockSize), data[15*blockSize:])
+		want = []Match{{Offset: 0, Block: 0}, {Offset: 3 * blockSize, Block: 15}}
+		if got := p.RollingMatch(target, blockSums, blockSize); !slices.Equal(got, want) {
+			t.Errorf("Poly = 0x%08x; RollingMatch(unmatched before tail) = %v; want %v", p.poly, got, want)
+		}
+
+		// Modify the first block, so that the partial block follows unmatched data.
+		target = slices.Concat([]byte("0123456789"), data[15*blockSize:])
+		want = []Match{{Offset: 10, Block: 15}}
+		if got := p.RollingMatch(target, blockSums, blockSize); !slices.Equal(got, want) {
+			t.Errorf("Poly = 0x%08x; RollingMatch(modified prefix) = %v; want %v", p.poly, got, want)
+		}
+		target = slices.Concat(data[:blockSize], make([]byte, 2*blockSize), data[15*blockSize:])
+		want = []Match{{Offset: 0, Block: 0}, {Offset: 3 * blockSize, Block: 15}}
+		if got := p.RollingMatch(target, blockSums, blockSize); !slices.Equal(got, want) {
+			t.Errorf("Poly = 0x%08x; RollingMatch(unmatched before tail) = %v; want %v", p.poly, got, want)
+		}
+	}
+}
+
+func TestBlockChecksumsParallel(t *testing.T) {
+	data := randData(4*minShardSize + 13)
+	for _, p := range polys {
+		for _, size := range []int{4 << 10, 100 << 10, 3 * minShardSize, len(data)} {
+			want := p.BlockChecksums(data, size)
+			for _, workers := range []int{0, 1, 3, 4, 7, 100} {
+				if got := p.BlockChecksumsParallel(data, size, workers); !slices.Equal(got, want) {
+					t.Errorf("Poly = 0x%08x; BlockChecksumsParallel(data, %d, %d) differs from BlockChecksums", p.poly, size, workers)
+				}
+			}
+		}
+		if got := p.BlockChecksumsParallel(data, 0, 4); got != nil {
+			t.Errorf("Poly = 0x%08x; BlockChecksumsParallel(data, 0, 4) = %x; want nil", p.poly, got)
+		}
+	}
+}
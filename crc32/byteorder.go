@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import "encoding/binary"
+
+// DetectByteOrder reports the byte order in which observed encodes the CRC-32
+// checksum of data, or false if it matches neither. If the checksum reads the
+// same in both byte orders, it reports [binary.BigEndian].
+func (p *Poly) DetectByteOrder(data []byte, observed [Size]byte) (binary.ByteOrder, bool) {
+	sum := p.Checksum(data)
+	switch {
+	case binary.BigEndian.Uint32(observed[:]) == sum:
+		return binary.BigEndian, true
+	case binary.LittleEndian.Uint32(observed[:]) == sum:
+		return binary.LittleEndian, true
+	default:
+		return nil, false
+	}
+}
+
+// ReconcileChecksum recomputes the CRC-32 checksum of data and reports the byte order
+// in which a stored checksum was persisted, given its bytes decoded as big-endian and as
+// little-endian, or false if neither matches. The recomputed value is returned either way.
+// If both match, it reports [binary.BigEndian].
+func (p *Poly) ReconcileChecksum(data []byte, storedBE, storedLE uint32) (value uint32, order binary.ByteOrder, ok bool) {
+	sum := p.Checksum(data)
+	switch sum {
+	case storedBE:
+		return sum, binary.BigEndian, true
+	case storedLE:
+		return sum, binary.LittleEndian, true
+	default:
+		return sum, nil, false
+	}
+}
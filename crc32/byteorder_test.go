@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestDetectByteOrder(t *testing.T) {
+	data := []byte("123456789")
+	for _, p := range polys {
+		sum := p.Checksum(data)
+		for _, want := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+			var observed [Size]byte
+			want.PutUint32(observed[:], sum)
+			if got, ok := p.DetectByteOrder(data, observed); !ok || got != want {
+				t.Errorf("Poly = 0x%08x; DetectByteOrder(%x) = (%v, %v); want (%v, true)", p.poly, observed, got, ok, want)
+			}
+		}
+		var observed [Size]byte
+		binary.BigEndian.PutUint32(observed[:], ^sum)
+		if got, ok := p.DetectByteOrder(data, observed); ok {
+			t.Errorf("Poly = 0x%08x; DetectByteOrder(%x) = (%v, true); want (nil, false)", p.poly, observed, got)
+		}
+	}
+}
+
+func TestReconcileChecksum(t *testing.T) {
+	data := []byte("123456789")
+	for _, p := range polys {
+		sum := p.Checksum(data)
+		for _, want := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+			var stored [Size]byte
+			want.PutUint32(stored[:], sum)
+			be, le := binary.BigEndian.Uint32(stored[:]), binary.LittleEndian.Uint32(stored[:])
+			if v, got, ok := p.ReconcileChecksum(data, be, le); !ok || got != want || v != sum {
+				t.Errorf("Poly = 0x%08x; ReconcileChecksum(0x%08x, 0x%08x) = (0x%08x, %v, %v); want (0x%08x, %v, true)", p.poly, be, le, v, got, ok, sum, want)
+			}
+		}
+		if v, got, ok := p.ReconcileChecksum(data, ^sum, ^sum); ok || got != nil || v != sum {
+			t.Errorf("Poly = 0x%08x; ReconcileChecksum(0x%08x, 0x%08x) = (0x%08x, %v, %v); want (0x%08x, nil, false)", p.poly, ^sum, ^sum, v, got, ok, sum)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// catalog holds models from the CRC RevEng catalogue by name.
+var catalog = map[string]Model{
+	"CRC-32/AIXM":       {Width: nBits, Poly: 0x814141ab, Init: 0x00000000, RefIn: false, RefOut: false, XorOut: 0x00000000},
+	"CRC-32/AUTOSAR":    {Width: nBits, Poly: 0xf4acfb13, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff},
+	"CRC-32/BASE91-D":   {Width: nBits, Poly: 0xa833982b, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff},
+	"CRC-32/BZIP2":      {Width: nBits, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: false, RefOut: false, XorOut: 0xffffffff},
+	"CRC-32/CD-ROM-EDC": {Width: nBits, Poly: 0x8001801b, Init: 0x00000000, RefIn: true, RefOut: true, XorOut: 0x00000000},
+	"CRC-32/CKSUM":      {Width: nBits, Poly: 0x04c11db7, Init: 0x00000000, RefIn: false, RefOut: false, XorOut: 0xffffffff},
+	"CRC-32/ISCSI":      {Width: nBits, Poly: 0x1edc6f41, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff},
+	"CRC-32/ISO-HDLC":   {Width: nBits, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff},
+	"CRC-32/JAMCRC":     {Width: nBits, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0x00000000},
+	"CRC-32/KOOPMAN":    {Width: nBits, Poly: 0x741b8cd7, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff},
+	"CRC-32/MEF":        {Width: nBits, Poly: 0x741b8cd7, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0x00000000},
+	"CRC-32/MPEG-2":     {Width: nBits, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: false, RefOut: false, XorOut: 0x00000000},
+	"CRC-32/XFER":       {Width: nBits, Poly: 0x000000af, Init: 0x00000000, RefIn: false, RefOut: false, XorOut: 0x00000000},
+}
+
+// checks holds the check values of the models in the catalog by name,
+// which are the checksums of "123456789" published in the CRC RevEng catalogue.
+var checks = map[string]uint32{
+	"CRC-32/AIXM":       0x3010bf7f,
+	"CRC-32/AUTOSAR":    0x1697d06a,
+	"CRC-32/BASE91-D":   0x87315576,
+	"CRC-32/BZIP2":      0xfc891918,
+	"CRC-32/CD-ROM-EDC": 0x6ec2edc4,
+	"CRC-32/CKSUM":      0x765e7680,
+	"CRC-32/ISCSI":      0xe3069283,
+	"CRC-32/ISO-HDLC":   0xcbf43926,
+	"CRC-32/JAMCRC":     0x340bc6d9,
+	"CRC-32/KOOPMAN":    0x2d3dd0ae,
+	"CRC-32/MEF":        0xd2c22f51,
+	"CRC-32/MPEG-2":     0x0376e6e7,
+	"CRC-32/XFER":       0xbd0be338,
+}
+
+// aliases maps alternative names to names in the catalog.
+var aliases = map[string]string{
+	"CRC-32Q":           "CRC-32/AIXM",
+	"CRC-32D":           "CRC-32/BASE91-D",
+	"CRC-32/AAL5":       "CRC-32/BZIP2",
+	"CRC-32/DECT-B":     "CRC-32/BZIP2",
+	"B-CRC-32":          "CRC-32/BZIP2",
+	"CKSUM":             "CRC-32/CKSUM",
+	"CRC-32/POSIX":      "CRC-32/CKSUM",
+	"CRC-32C":           "CRC-32/ISCSI",
+	"CRC-32/CASTAGNOLI": "CRC-32/ISCSI",
+	"CRC-32/BASE91-C":   "CRC-32/ISCSI",
+	"CRC-32/INTERLAKEN": "CRC-32/ISCSI",
+	"CRC-32":            "CRC-32/ISO-HDLC",
+	"CRC-32/IEEE":       "CRC-32/ISO-HDLC",
+	"CRC-32/ADCCP":      "CRC-32/ISO-HDLC",
+	"CRC-32/V-42":       "CRC-32/ISO-HDLC",
+	"CRC-32/XZ":         "CRC-32/ISO-HDLC",
+	"PKZIP":             "CRC-32/ISO-HDLC",
+	"JAMCRC":            "CRC-32/JAMCRC",
+	"XFER":              "CRC-32/XFER",
+}
+
+// Lookup returns the [Model] of the named CRC-32 algorithm from the CRC RevEng
+// catalogue, such as "CRC-32/ISO-HDLC", and reports whether it was found.
+// Names are case-insensitive, and well-known aliases are also accepted.
+func Lookup(name string) (Model, bool) {
+	name = strings.ToUpper(name)
+	if canon, ok := aliases[name]; ok {
+		name = canon
+	}
+	m, ok := catalog[name]
+	return m, ok
+}
+
+// Names returns the sorted names of the algorithms known by [Lookup], excluding aliases.
+func Names() []string {
+	return slices.Sorted(maps.Keys(catalog))
+}
+
+// A TestVector is an input and its checksum as documented by a model's specification.
+type TestVector struct {
+	Input string // input to the model
+	Want  uint32 // documented checksum of the input
+}
+
+// TestVectors returns the test vectors documented for the model by the CRC RevEng
+// catalogue, if it's one of the models known by [Lookup], or nil otherwise. Running
+// them validates the implementation of the model.
+func (m Model) TestVectors() []TestVector {
+	for name, c := range catalog {
+		if c == m {
+			return []TestVector{{Input: "123456789", Want: checks[name]}}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	// Check values are published in the CRC RevEng catalogue.
+	published := map[string]uint32{
+		"CRC-32/AIXM":       0x3010bf7f,
+		"CRC-32/AUTOSAR":    0x1697d06a,
+		"CRC-32/BASE91-D":   0x87315576,
+		"CRC-32/BZIP2":      0xfc891918,
+		"CRC-32/CD-ROM-EDC": 0x6ec2edc4,
+		"CRC-32/CKSUM":      0x765e7680,
+		"CRC-32/ISCSI":      0xe3069283,
+		"CRC-32/ISO-HDLC":   0xcbf43926,
+		"CRC-32/JAMCRC":     0x340bc6d9,
+		"CRC-32/KOOPMAN":    0x2d3dd0ae,
+		"CRC-32/MEF":        0xd2c22f51,
+		"CRC-32/MPEG-2":     0x0376e6e7,
+		"CRC-32/XFER":       0xbd0be338,
+	}
+	names := Names()
+	if !slices.IsSorted(names) || len(names) != len(published) {
+		t.Fatalf("Names() = %q; want %d sorted names", names, len(published))
+	}
+	for _, name := range names {
+		m, ok := Lookup(name)
+		if !ok {
+			t.Errorf("Lookup(%q) not found", name)
+			continue
+		}
+		if got, want := m.Check(), published[name]; got != want {
+			t.Errorf("Lookup(%q).Check() = 0x%08x; want 0x%08x", name, got, want)
+		}
+		if got, want := m.TestVectors(), []TestVector{{"123456789", published[name]}}; !slices.Equal(got, want) {
+			t.Errorf("Lookup(%q).TestVectors() = %+v; want %+v", name, got, want)
+		}
+		if lower, ok := Lookup(strings.ToLower(name)); !ok || lower != m {
+			t.Errorf("Lookup(%q) = %+v, %v; want %+v, true", strings.ToLower(name), lower, ok, m)
+		}
+	}
+	for alias, name := range aliases {
+		if got, ok := Lookup(alias); !ok || got != catalog[name] {
+			t.Errorf("Lookup(%q) = %+v, %v; want %s", alias, got, ok, name)
+		}
+	}
+	if _, ok := Lookup("CRC-32/UNKNOWN"); ok {
+		t.Errorf("Lookup(%q) found an unknown algorithm", "CRC-32/UNKNOWN")
+	}
+}
+
+func TestTestVectors(t *testing.T) {
+	for _, name := range Names() {
+		m, _ := Lookup(name)
+		for _, v := range m.TestVectors() {
+			if got := m.Checksum([]byte(v.Input)); got != v.Want {
+				t.Errorf("Lookup(%q).Checksum(%q) = 0x%08x; want 0x%08x", name, v.Input, got, v.Want)
+			}
+		}
+	}
+	m, _ := Lookup(Names()[0])
+	m.Init ^= 1
+	if got := m.TestVectors(); got != nil {
+		t.Errorf("Model = %+v; TestVectors() = %+v; want nil", m, got)
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// ErrInvalidCheckpoints is returned when decoding checkpoints that are malformed.
+var ErrInvalidCheckpoints = errors.New("crc32: invalid checkpoints")
+
+// A Checkpoint is the CRC-32 checksum of a stream up to an offset.
+type Checkpoint struct {
+	Offset int64  // length of the stream covered by Sum
+	Sum    uint32 // checksum of the stream up to Offset
+}
+
+// EncodeCheckpoints writes a compact encoding of the checkpoints to w. Each offset is
+// encoded as a varint of its difference from the previous offset and each checksum is
+// encoded in big-endian byte order, so increasing offsets are encoded compactly.
+func EncodeCheckpoints(w io.Writer, checkpoints []Checkpoint) error {
+	b := binary.AppendUvarint(nil, uint64(len(checkpoints)))
+	var prev int64
+	for _, c := range checkpoints {
+		b = binary.AppendVarint(b, c.Offset-prev)
+		b = binary.BigEndian.AppendUint32(b, c.Sum)
+		prev = c.Offset
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+// DecodeCheckpoints reads checkpoints encoded by [EncodeCheckpoints] from r.
+// It doesn't read beyond the end of the encoding. If the encoding is truncated, it
+// returns [io.ErrUnexpectedEOF]; if it's malformed, it returns [ErrInvalidCheckpoints].
+func DecodeCheckpoints(r io.Reader) ([]Checkpoint, error) {
+	br := byteReader{r: r}
+	n, err := binary.ReadUvarint(&br)
+	if err != nil {
+		return nil, br.varintErr(err)
+	}
+	// Don't trust the count for allocation; each checkpoint takes at least Size+1 bytes.
+	checkpoints := make([]Checkpoint, 0, min(n, 1024))
+	var prev int64
+	for range n {
+		delta, err := binary.ReadVarint(&br)
+		if err != nil {
+			return nil, noEOF(br.varintErr(err))
+		}
+		var b [Size]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return nil, noEOF(err)
+		}
+		prev += delta
+		checkpoints = append(checkpoints, Checkpoint{Offset: prev, Sum: binary.BigEndian.Uint32(b[:])})
+	}
+	return checkpoints, nil
+}
+
+// byteReader reads one byte at a time from r, so it doesn't read ahead.
+type byteReader struct {
+	r   io.Reader
+	buf [1]byte
+	err error
+}
+
+func (br *byteReader) ReadByte() (byte, error) {
+	_, br.err = io.ReadFull(br.r, br.buf[:])
+	return br.buf[0], br.err
+}
+
+// varintErr returns err if reading failed, or else
+// [ErrInvalidCheckpoints] for a malformed varint.
+func (br *byteReader) varintErr(err error) error {
+	if br.err != nil {
+		return err
+	}
+	return ErrInvalidCheckpoints
+}
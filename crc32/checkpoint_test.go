@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+)
+
+func TestEncodeCheckpoints(t *testing.T) {
+	data := randData(100 << 10)
+	p := polys[0]
+	var checkpoints []Checkpoint
+	for off := 0; off <= len(data); off += 4 << 10 {
+		checkpoints = append(checkpoints, Checkpoint{int64(off), p.Checksum(data[:off])})
+	}
+	tests := [][]Checkpoint{
+		nil,
+		checkpoints,
+		{{Offset: 100, Sum: 1}, {Offset: 50, Sum: 2}, {Offset: -1, Sum: 3}},
+	}
+	for _, want := range tests {
+		var buf bytes.Buffer
+		if err := EncodeCheckpoints(&buf, want); err != nil {
+			t.Fatalf("EncodeCheckpoints() failed: %v", err)
+		}
+		buf.WriteString("next")
+		got, err := DecodeCheckpoints(&buf)
+		if err != nil {
+			t.Fatalf("DecodeCheckpoints() failed: %v", err)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("DecodeCheckpoints() = %v; want %v", got, want)
+		}
+		if rest := buf.String(); rest != "next" {
+			t.Errorf("DecodeCheckpoints() left %q; want %q", rest, "next")
+		}
+	}
+
+	var buf bytes.Buffer
+	EncodeCheckpoints(&buf, checkpoints)
+	if naive := len(checkpoints) * (8 + Size); buf.Len() >= naive {
+		t.Errorf("EncodeCheckpoints() wrote %d bytes; want fewer than %d", buf.Len(), naive)
+	}
+
+	blob := buf.Bytes()
+	errTests := []struct {
+		name string
+		blob []byte
+		want error
+	}{
+		{"empty", nil, io.EOF},
+		{"truncated", blob[:len(blob)-1], io.ErrUnexpectedEOF},
+		{"varint", []byte{0x80}, io.ErrUnexpectedEOF},
+		{"count", []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}, ErrInvalidCheckpoints},
+	}
+	for _, tt := range errTests {
+		if _, err := DecodeCheckpoints(bytes.NewReader(tt.blob)); !errors.Is(err, tt.want) {
+			t.Errorf("%s: DecodeCheckpoints() error = %v; want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+// Checksummer computes and combines CRC-32 checksums. It's satisfied by [*Poly],
+// so code that depends on it may be given a fake implementation in tests.
+type Checksummer interface {
+	// Checksum returns the checksum of data.
+	Checksum(data []byte) uint32
+	// Update returns the result of adding the bytes in data to the sum.
+	Update(sum uint32, data []byte) uint32
+	// Combine returns the result of adding n bytes with the next sum to the prev sum.
+	Combine(prev, next uint32, n int64) uint32
+}
+
+var _ Checksummer = (*Poly)(nil)
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import "testing"
+
+// fakeChecksummer is a trivial Checksummer whose checksum is the sum of the bytes,
+// as a test might use in place of a Poly.
+type fakeChecksummer struct{}
+
+func (fakeChecksummer) Checksum(data []byte) uint32 {
+	return fakeChecksummer{}.Update(0, data)
+}
+
+func (fakeChecksummer) Update(sum uint32, data []byte) uint32 {
+	for _, b := range data {
+		sum += uint32(b)
+	}
+	return sum
+}
+
+func (fakeChecksummer) Combine(prev, next uint32, n int64) uint32 {
+	return prev + next
+}
+
+// checksumParts stands in for downstream code that depends on a Checksummer.
+func checksumParts(c Checksummer, a, b []byte) uint32 {
+	return c.Combine(c.Checksum(a), c.Checksum(b), int64(len(b)))
+}
+
+func TestChecksummer(t *testing.T) {
+	a, b := []byte("hello, "), []byte("world")
+	for _, p := range polys {
+		if got, want := checksumParts(p, a, b), p.Checksum([]byte("hello, world")); got != want {
+			t.Errorf("Poly = 0x%08x; checksumParts() = 0x%08x; want 0x%08x", p.poly, got, want)
+		}
+	}
+	var fake fakeChecksummer
+	if got, want := checksumParts(fake, a, b), fake.Checksum([]byte("hello, world")); got != want {
+		t.Errorf("fake checksumParts() = 0x%08x; want 0x%08x", got, want)
+	}
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import "context"
+
+type polyKey struct{}
+
+// WithPoly returns a copy of ctx carrying p, so that code receiving the context may
+// compute checksums with [FromContext] or [ChecksumCtx] without being passed p.
+// It's optional sugar; passing a [Poly] explicitly is equivalent.
+func WithPoly(ctx context.Context, p *Poly) context.Context {
+	return context.WithValue(ctx, polyKey{}, p)
+}
+
+// FromContext returns the [Poly] carried by ctx, or [IEEE] if there isn't one.
+func FromContext(ctx context.Context) *Poly {
+	if p, ok := ctx.Value(polyKey{}).(*Poly); ok && p != nil {
+		return p
+	}
+	return IEEE()
+}
+
+// ChecksumCtx returns the CRC-32 checksum of data using the [Poly] carried by ctx,
+// as returned by [FromContext].
+func ChecksumCtx(ctx context.Context, data []byte) uint32 {
+	return FromContext(ctx).Checksum(data)
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"context"
+	"testing"
+)
+
+func TestContextPoly(t *testing.T) {
+	data := []byte("hello, world")
+	ctx := context.Background()
+	if got := FromContext(ctx); got != IEEE() {
+		t.Errorf("FromContext(Background) = 0x%08x; want IEEE", got.poly)
+	}
+	if got, want := ChecksumCtx(ctx, data), IEEE().Checksum(data); got != want {
+		t.Errorf("ChecksumCtx(Background) = 0x%08x; want 0x%08x", got, want)
+	}
+	for _, p := range polys {
+		ctx := WithPoly(ctx, p)
+		if got := FromContext(ctx); got != p {
+			t.Errorf("FromContext(WithPoly(0x%08x)) = 0x%08x", p.poly, got.poly)
+		}
+		if got, want := ChecksumCtx(ctx, data), p.Checksum(data); got != want {
+			t.Errorf("Poly = 0x%08x; ChecksumCtx() = 0x%08x; want 0x%08x", p.poly, got, want)
+		}
+	}
+	if got := FromContext(WithPoly(ctx, nil)); got != IEEE() {
+		t.Errorf("FromContext(WithPoly(nil)) = 0x%08x; want IEEE", got.poly)
+	}
+}
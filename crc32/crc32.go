@@ -15,8 +15,14 @@ package crc32
 
 import (
 	"encoding"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"hash"
 	"hash/crc32"
+	"io"
+	"sync"
 
 	"bursavich.dev/crc/internal/lazy"
 )
@@ -73,9 +79,72 @@ type Hash interface {
 }
 
 // New creates a new [Hash] computing the CRC-32 checksum using the polynomial
-// represented by the [Poly].
+// represented by the [Poly]. The returned [Hash] also implements [io.ReaderFrom],
+// which reads through a pooled buffer, and [encoding.TextMarshaler] and
+// [encoding.TextUnmarshaler], which encode the current sum as 8 hex digits
+// in big-endian byte order. Since the sum is the whole state of the hash, unmarshaling
+// text restores it as if the data that produced the sum had been written.
 func New(p *Poly) Hash {
-	return crc32.New(p.stdlib).(Hash)
+	return newDigest(p)
+}
+
+func newDigest(p *Poly) digest {
+	return digest{crc32.New(p.stdlib).(Hash)}
+}
+
+type digest struct {
+	Hash
+}
+
+func (d digest) ReadFrom(r io.Reader) (n int64, err error) {
+	buf := bufPool.Get().(*[]byte)
+	defer bufPool.Put(buf)
+	for {
+		m, err := r.Read(*buf)
+		d.Write((*buf)[:m])
+		n += int64(m)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			return n, err
+		}
+	}
+}
+
+func (d digest) MarshalText() ([]byte, error) {
+	return hex.AppendEncode(nil, d.Sum(nil)), nil
+}
+
+func (d digest) UnmarshalText(text []byte) error {
+	if len(text) != hex.EncodedLen(Size) {
+		return fmt.Errorf("crc32: invalid checksum text length %d", len(text))
+	}
+	var sum [Size]byte
+	if _, err := hex.Decode(sum[:], text); err != nil {
+		return fmt.Errorf("crc32: invalid checksum text: %w", err)
+	}
+	// The marshaled binary state ends with the sum.
+	state, err := d.Hash.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	copy(state[len(state)-Size:], sum[:])
+	return d.Hash.UnmarshalBinary(state)
+}
+
+// NewLE is like [New], but the Sum method of the returned [Hash] lays the value
+// out in little-endian byte order. The Sum32 method is unaffected.
+func NewLE(p *Poly) Hash {
+	return leDigest{newDigest(p)}
+}
+
+type leDigest struct {
+	digest
+}
+
+func (d leDigest) Sum(b []byte) []byte {
+	return binary.LittleEndian.AppendUint32(b, d.Sum32())
 }
 
 const nBits = Size * 8
@@ -87,10 +156,24 @@ type Poly struct {
 	stdlib *crc32.Table
 }
 
+// StrictPolyChecks makes [MakePoly] panic if the polynomial appears to be given in
+// normal form, also known as MSB-first form, instead of LSB-first form. It should only
+// be set during initialization.
+//
+// The check relies on the x^0 term, which is the highest bit in LSB-first form and
+// which every polynomial used in practice has. It catches normal form polynomials
+// whose highest bit is unset, such as 0x04C11DB7 for IEEE, which must be given as
+// 0xEDB88320, but not those whose highest bit happens to be set.
+var StrictPolyChecks bool
+
 // MakePoly returns a [Poly] constructed from the specified polynomial
 // given in LSB-first form, also known as reversed representation.
-// The returned [Poly] may be shared and must not be modified.
+// The returned [Poly] may be shared and must not be modified. Polys are cached
+// for the life of the process, so each polynomial's tables are computed once.
 func MakePoly(poly uint32) *Poly {
+	if StrictPolyChecks && poly&(1<<(nBits-1)) == 0 {
+		panic(fmt.Sprintf("crc32: polynomial 0x%08x has no x^0 term; it may be in normal form", poly))
+	}
 	switch poly {
 	case crc32.IEEE:
 		return IEEE()
@@ -99,8 +182,20 @@ func MakePoly(poly uint32) *Poly {
 	case crc32.Koopman:
 		return Koopman()
 	default:
-		return makePoly(poly)
+		return cachedPoly(poly)
+	}
+}
+
+// polyCache holds the [Poly] of each polynomial that isn't named, once made,
+// so that it's shared like the named ones. It's a map[uint32]*Poly.
+var polyCache sync.Map
+
+func cachedPoly(poly uint32) *Poly {
+	if p, ok := polyCache.Load(poly); ok {
+		return p.(*Poly)
 	}
+	p, _ := polyCache.LoadOrStore(poly, makePoly(poly))
+	return p.(*Poly)
 }
 
 func makePoly(poly uint32) *Poly {
@@ -114,6 +209,7 @@ func makePoly(poly uint32) *Poly {
 		v = p.multModP(v, v)
 		p.x2nTbl[n] = v
 	}
+	p.selfCheck()
 	return p
 }
 
@@ -127,6 +223,24 @@ func (p *Poly) Checksum(data []byte) uint32 {
 	return crc32.Update(0, p.stdlib, data)
 }
 
+// ChecksumString returns the CRC-32 checksum of s in big-endian byte order.
+// It hashes the bytes of s as-is and does no Unicode normalization.
+func (p *Poly) ChecksumString(s string) uint32 {
+	return crc32.Update(0, p.stdlib, []byte(s))
+}
+
+// Sum returns the CRC-32 checksum of data laid out in big-endian byte order,
+// like the Sum method of a [Hash].
+func (p *Poly) Sum(data []byte) []byte {
+	return p.AppendChecksum(make([]byte, 0, Size), data)
+}
+
+// AppendChecksum appends the CRC-32 checksum of data to dst in big-endian byte order
+// and returns the extended buffer.
+func (p *Poly) AppendChecksum(dst, data []byte) []byte {
+	return binary.BigEndian.AppendUint32(dst, p.Checksum(data))
+}
+
 // Update returns the result of adding the bytes in data to the sum.
 func (p *Poly) Update(sum uint32, data []byte) uint32 {
 	return crc32.Update(sum, p.stdlib, data)
@@ -143,6 +257,154 @@ func (p *Poly) Combine(prev, next uint32, n int64) uint32 {
 	return p.multModP(prev, p.x2NModP(n, 3)) ^ next
 }
 
+// Uncombine is the inverse of [Poly.Combine]. It returns the prev sum given the whole
+// sum of prev followed by n bytes with the suffix sum. If n isn't positive, it returns
+// whole, like [Poly.Combine] returns prev. If whole equals suffix, it returns zero, which
+// is the sum of no bytes. It panics if the polynomial has no x^0 term, in which case
+// the prev sum may not be unique.
+func (p *Poly) Uncombine(whole, suffix uint32, n int64) uint32 {
+	if n <= 0 {
+		return whole
+	}
+	v := whole ^ suffix
+	if v == 0 {
+		return 0
+	}
+	return p.multModP(v, p.xInvNModP(8*n))
+}
+
+// CombineBits is like [Poly.Combine], but the next sum covers nbits bits, which needn't be
+// a multiple of 8, for bit-oriented protocols. Bits are taken least significant first,
+// like the bits of bytes in the other methods.
+func (p *Poly) CombineBits(prev, next uint32, nbits int64) uint32 {
+	if prev == 0 {
+		return next
+	}
+	if nbits <= 0 {
+		return prev
+	}
+	return p.multModP(prev, p.x2NModP(nbits, 0)) ^ next
+}
+
+// CombineOnce is like [Poly.Combine], but it doesn't construct a [Poly] or its tables
+// for the specified polynomial, which is given in LSB-first form. It's cheaper when
+// combining only once with a polynomial that isn't otherwise used.
+func CombineOnce(poly uint32, prev, next uint32, n int64) uint32 {
+	if prev == 0 {
+		return next
+	}
+	if n <= 0 {
+		return prev
+	}
+	p := Poly{poly: poly}
+	v := uint32(1) << (nBits - 1)  // x^0
+	sq := uint32(1) << (nBits - 9) // x^8
+	for {
+		if n&1 != 0 {
+			v = p.multModP(sq, v)
+		}
+		if n >>= 1; n == 0 {
+			break
+		}
+		sq = p.multModP(sq, sq)
+	}
+	return p.multModP(prev, v) ^ next
+}
+
+// CombineBytes is like [Poly.Combine], but operates on sums laid out in big-endian byte order.
+func (p *Poly) CombineBytes(prev, next [Size]byte, n int64) [Size]byte {
+	sum := p.Combine(binary.BigEndian.Uint32(prev[:]), binary.BigEndian.Uint32(next[:]), n)
+	var b [Size]byte
+	binary.BigEndian.PutUint32(b[:], sum)
+	return b
+}
+
+// StripPrefix returns the sum of a body of n bytes given the whole sum
+// of the header followed by the body.
+func (p *Poly) StripPrefix(whole uint32, header []byte, n int64) uint32 {
+	return whole ^ p.Combine(p.Checksum(header), 0, n)
+}
+
+// ExtendZeros returns the result of adding n zero bytes to the sum, as if by [Poly.Update]
+// with a buffer of n zeros, in time logarithmic in n. It's useful for the holes of sparse
+// files. Note that it differs from combining with a zero next sum, which is the sum of no
+// bytes rather than of n zeros. It returns the sum unchanged if n isn't positive.
+func (p *Poly) ExtendZeros(sum uint32, n int64) uint32 {
+	if n <= 0 {
+		return sum
+	}
+	// The sum is inverted before and after it's updated, like in the stdlib.
+	return ^p.multModP(p.x2NModP(n, 3), ^sum)
+}
+
+// Residue returns the CRC-32 checksum of any data followed by its own checksum laid
+// out in little-endian byte order, as by the Sum method of a [Hash] returned by [NewLE],
+// so intact frames can be verified by checking that the checksum of the whole frame
+// equals the residue. It's nonzero, because sums are inverted before and after they're
+// updated, and it doesn't hold for checksums laid out in big-endian byte order.
+func (p *Poly) Residue() uint32 {
+	// The little-endian checksum cancels the inverted sum held in the register,
+	// which is left as it is for the empty sum and then shifted by Size zero bytes.
+	return p.ExtendZeros(0, Size)
+}
+
+// ExtendFiller returns the result of adding n copies of the filler byte b to the sum.
+// It takes time logarithmic in n.
+func (p *Poly) ExtendFiller(sum uint32, b byte, n int64) uint32 {
+	if b == 0 {
+		return p.ExtendZeros(sum, n)
+	}
+	// Fold in the checksums of runs doubling in length.
+	run, fill := int64(1), p.Checksum([]byte{b})
+	for ; n > 0; n >>= 1 {
+		if n&1 != 0 {
+			sum = p.Combine(sum, fill, run)
+		}
+		if n > 1 {
+			fill = p.Combine(fill, fill, run)
+			run <<= 1
+		}
+	}
+	return sum
+}
+
+// AppendToForce returns the bytes that, when appended to data, make its CRC-32
+// checksum equal the target. It panics if the polynomial has no x^0 term, in which
+// case such bytes may not exist.
+func (p *Poly) AppendToForce(data []byte, target uint32) [Size]byte {
+	// The sum of data followed by w is (sum(data) * x^nBits) + sum(zeros) + w(x) * x^nBits,
+	// where w(x) is read with its first bit as the highest degree coefficient.
+	v := target ^ p.Combine(p.Checksum(data), 0, Size) ^ p.ExtendZeros(0, Size)
+	if v != 0 {
+		v = p.multModP(v, p.xInvNModP(nBits))
+	}
+	var b [Size]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	return b
+}
+
+// PatchToTarget overwrites the [Size] bytes of data at pos so that the CRC-32 checksum
+// of data equals the target. It returns an error without modifying data if the bytes
+// are out of range or if the polynomial has no x^0 term, in which case such bytes may
+// not exist.
+func (p *Poly) PatchToTarget(data []byte, pos int, target uint32) error {
+	if pos < 0 || pos > len(data)-Size {
+		return errors.New("crc32: patch position out of range")
+	}
+	if p.poly&(1<<(nBits-1)) == 0 {
+		return errors.New("crc32: polynomial has no x^0 term")
+	}
+	// Like AppendToForce, but the patch is followed by the rest of data,
+	// which multiplies its contribution by x^(8*len(rest)).
+	rest := data[pos+Size:]
+	v := target ^ p.Update(p.ExtendZeros(p.Checksum(data[:pos]), Size), rest)
+	if v != 0 {
+		v = p.multModP(v, p.xInvNModP(nBits+8*int64(len(rest))))
+	}
+	binary.LittleEndian.PutUint32(data[pos:], v)
+	return nil
+}
+
 // multModP returns (a(x) * b(x)) modulo p(x), where p(x) is the CRC polynomial, reflected.
 // For speed, this requires that a is not zero.
 func (p *Poly) multModP(a, b uint32) uint32 {
@@ -173,3 +435,22 @@ func (p *Poly) x2NModP(n int64, k uint32) uint32 {
 	}
 	return v
 }
+
+// xInvNModP returns x^-n modulo p(x).
+// It panics if x isn't invertible, which is when p(x) has no x^0 term.
+func (p *Poly) xInvNModP(n int64) uint32 {
+	if p.poly&(1<<(nBits-1)) == 0 {
+		panic("crc32: polynomial has no x^0 term")
+	}
+	v := uint32(1) << (nBits - 1) // x^0
+	sq := p.poly<<1 | 1           // x^-1 = (p(x) - 1) / x
+	for {
+		if n&1 != 0 {
+			v = p.multModP(sq, v)
+		}
+		if n >>= 1; n == 0 {
+			return v
+		}
+		sq = p.multModP(sq, sq)
+	}
+}
@@ -7,9 +7,18 @@
 package crc32
 
 import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"fmt"
 	"hash/crc32"
+	"io"
 	"math/bits"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"testing/iotest"
 
 	"bursavich.dev/crc/internal/tests"
 )
@@ -22,6 +31,181 @@ func TestPoly(t *testing.T) {
 	tests.TestPoly(t, testPoly)
 }
 
+func TestWidth(t *testing.T) {
+	p := IEEE()
+	tests.TestWidth(t, tests.Width[uint32]{
+		Bits:        nBits,
+		Size:        Size,
+		Check:       0xcbf43926,
+		Checksum:    p.Checksum,
+		Update:      p.Update,
+		Combine:     p.Combine,
+		ExtendZeros: p.ExtendZeros,
+	})
+}
+
+func TestCheck(t *testing.T) {
+	// Check values are the checksums of "123456789" published in the CRC RevEng catalogue.
+	tests := []struct {
+		name string
+		poly *Poly
+		want uint32
+	}{
+		{"CRC-32/ISO-HDLC", IEEE(), 0xcbf43926},
+		{"CRC-32/ISCSI", Castagnoli(), 0xe3069283},
+		{"CRC-32/KOOPMAN", Koopman(), 0x2d3dd0ae},
+	}
+	for _, tt := range tests {
+		if got := tt.poly.ChecksumString("123456789"); got != tt.want {
+			t.Errorf("%s: Checksum(\"123456789\") = 0x%08x; want 0x%08x", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNewLE(t *testing.T) {
+	data := []byte("hello, world")
+	for _, p := range polys {
+		be, le := New(p), NewLE(p)
+		be.Write(data)
+		le.Write(data)
+		if got, want := le.Sum32(), be.Sum32(); got != want {
+			t.Errorf("Poly = 0x%08x; NewLE().Sum32() = 0x%08x; want 0x%08x", p.poly, got, want)
+		}
+		want := be.Sum(nil)
+		slices.Reverse(want)
+		if got := le.Sum([]byte("prefix")); !bytes.Equal(got, append([]byte("prefix"), want...)) {
+			t.Errorf("Poly = 0x%08x; NewLE().Sum(prefix) = %x; want prefix followed by %x", p.poly, got, want)
+		}
+	}
+}
+
+func TestSum(t *testing.T) {
+	for _, p := range polys {
+		for _, data := range [][]byte{nil, []byte("123456789"), randData(1000)} {
+			h := New(p)
+			h.Write(data)
+			want := h.Sum(nil)
+			if got := p.Sum(data); !bytes.Equal(got, want) {
+				t.Errorf("Poly = 0x%08x; Sum(%d bytes) = %x; want %x", p.poly, len(data), got, want)
+			}
+			if got := p.AppendChecksum([]byte("frame"), data); !bytes.Equal(got, append([]byte("frame"), want...)) {
+				t.Errorf("Poly = 0x%08x; AppendChecksum(frame, %d bytes) = %x; want frame followed by %x", p.poly, len(data), got, want)
+			}
+		}
+	}
+}
+
+func TestHashReadFrom(t *testing.T) {
+	data := randData(3*bufSize + 11)
+	for _, p := range polys {
+		h := New(p)
+		h.Write(data[:10])
+		rf, ok := h.(io.ReaderFrom)
+		if !ok {
+			t.Fatalf("Poly = 0x%08x; New() doesn't implement io.ReaderFrom", p.poly)
+		}
+		n, err := rf.ReadFrom(iotest.HalfReader(bytes.NewReader(data[10:])))
+		if err != nil || n != int64(len(data)-10) {
+			t.Errorf("Poly = 0x%08x; ReadFrom() = (%d, %v); want (%d, nil)", p.poly, n, err, len(data)-10)
+		}
+		if got, want := h.Sum32(), p.Checksum(data); got != want {
+			t.Errorf("Poly = 0x%08x; Sum32() after ReadFrom = 0x%08x; want 0x%08x", p.poly, got, want)
+		}
+
+		// Marshaling round-trips after reading.
+		state, err := h.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Poly = 0x%08x; MarshalBinary() failed: %v", p.poly, err)
+		}
+		resumed := New(p)
+		if err := resumed.UnmarshalBinary(state); err != nil {
+			t.Fatalf("Poly = 0x%08x; UnmarshalBinary() failed: %v", p.poly, err)
+		}
+		if _, err := io.Copy(resumed, bytes.NewReader(data)); err != nil {
+			t.Fatalf("Poly = 0x%08x; io.Copy() failed: %v", p.poly, err)
+		}
+		if got, want := resumed.Sum32(), p.Update(p.Checksum(data), data); got != want {
+			t.Errorf("Poly = 0x%08x; Sum32() after io.Copy = 0x%08x; want 0x%08x", p.poly, got, want)
+		}
+	}
+}
+
+func TestHashText(t *testing.T) {
+	data := randData(1000)
+	for _, p := range polys {
+		h := New(p)
+		h.Write(data[:300])
+		text, err := h.(encoding.TextMarshaler).MarshalText()
+		if want := fmt.Sprintf("%08x", p.Checksum(data[:300])); err != nil || string(text) != want {
+			t.Errorf("Poly = 0x%08x; MarshalText() = (%q, %v); want (%q, nil)", p.poly, text, err, want)
+		}
+		resumed := New(p)
+		if err := resumed.(encoding.TextUnmarshaler).UnmarshalText(text); err != nil {
+			t.Fatalf("Poly = 0x%08x; UnmarshalText(%q) failed: %v", p.poly, text, err)
+		}
+		resumed.Write(data[300:])
+		if got, want := resumed.Sum32(), p.Checksum(data); got != want {
+			t.Errorf("Poly = 0x%08x; Sum32() after UnmarshalText = 0x%08x; want 0x%08x", p.poly, got, want)
+		}
+	}
+	for _, text := range []string{"", "123", strings.Repeat("0", 2*Size+1), strings.Repeat("x", 2*Size)} {
+		if err := New(polys[0]).(encoding.TextUnmarshaler).UnmarshalText([]byte(text)); err == nil {
+			t.Errorf("UnmarshalText(%q) succeeded; want error", text)
+		}
+	}
+}
+
+func TestHashInterfaces(t *testing.T) {
+	// Every variant of New has the optional methods of the Hash returned by New.
+	p := polys[0]
+	for name, h := range map[string]Hash{"New": New(p), "NewLE": NewLE(p), "NewStrict": NewStrict(p)} {
+		if _, ok := h.(io.ReaderFrom); !ok {
+			t.Errorf("%s() doesn't implement io.ReaderFrom", name)
+		}
+		if _, ok := h.(encoding.TextMarshaler); !ok {
+			t.Errorf("%s() doesn't implement encoding.TextMarshaler", name)
+		}
+		if _, ok := h.(encoding.TextUnmarshaler); !ok {
+			t.Errorf("%s() doesn't implement encoding.TextUnmarshaler", name)
+		}
+	}
+}
+
+func TestMakePolyShared(t *testing.T) {
+	// Named polynomials and their tables are shared rather than rebuilt.
+	tests := []struct {
+		name string
+		poly uint32
+		want *Poly
+	}{
+		{"IEEE", crc32.IEEE, IEEE()},
+		{"Castagnoli", crc32.Castagnoli, Castagnoli()},
+		{"Koopman", crc32.Koopman, Koopman()},
+	}
+	for _, tt := range tests {
+		if got := MakePoly(tt.poly); got != tt.want {
+			t.Errorf("MakePoly(%s) = %p; want %p", tt.name, got, tt.want)
+		}
+	}
+
+	// Other polynomials are cached when first made.
+	made := make([]*Poly, 8)
+	var wg sync.WaitGroup
+	for i := range made {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			made[i] = MakePoly(0x9b1d5a87)
+		}()
+	}
+	wg.Wait()
+	for _, p := range made {
+		if p != made[0] {
+			t.Errorf("MakePoly(0x9b1d5a87) = %p; want %p", p, made[0])
+		}
+	}
+}
+
 var polys = []*Poly{
 	MakePoly(crc32.IEEE),
 	MakePoly(crc32.Castagnoli),
@@ -45,5 +229,220 @@ func testPoly(t *testing.T, a, b []byte) {
 		if got := p.Combine(aSum, bSum, int64(len(b))); got != want {
 			t.Errorf("Poly = 0x%08x; Combine(0x%08x, 0x%08x, %d) = 0x%08x; want 0x%08x", p.poly, aSum, bSum, len(b), got, want)
 		}
+
+		if p.poly&(1<<(nBits-1)) != 0 {
+			if got := p.Uncombine(want, bSum, int64(len(b))); got != aSum {
+				t.Errorf("Poly = 0x%08x; Uncombine(0x%08x, 0x%08x, %d) = 0x%08x; want 0x%08x", p.poly, want, bSum, len(b), got, aSum)
+			}
+		}
+
+		if got := CombineOnce(p.poly, aSum, bSum, int64(len(b))); got != want {
+			t.Errorf("Poly = 0x%08x; CombineOnce(0x%08x, 0x%08x, %d) = 0x%08x; want 0x%08x", p.poly, aSum, bSum, len(b), got, want)
+		}
+
+		// The empty sum is an identity on either side.
+		empty := p.Checksum(nil)
+		for _, sum := range []uint32{aSum, bSum, want} {
+			if got := p.Combine(sum, empty, 0); got != sum {
+				t.Errorf("Poly = 0x%08x; Combine(0x%08x, 0x%08x, 0) = 0x%08x; want 0x%08x", p.poly, sum, empty, got, sum)
+			}
+			if got := p.Combine(empty, sum, int64(len(b))); got != sum {
+				t.Errorf("Poly = 0x%08x; Combine(0x%08x, 0x%08x, %d) = 0x%08x; want 0x%08x", p.poly, empty, sum, len(b), got, sum)
+			}
+			// A non-positive length ignores next, unless prev is the empty sum.
+			wantNeg := sum
+			if sum == empty {
+				wantNeg = bSum
+			}
+			if got := p.Combine(sum, bSum, -int64(len(b))); got != wantNeg {
+				t.Errorf("Poly = 0x%08x; Combine(0x%08x, 0x%08x, %d) = 0x%08x; want 0x%08x", p.poly, sum, bSum, -len(b), got, wantNeg)
+			}
+		}
+
+		if got := p.CombineBytes(sumBytes(aSum), sumBytes(bSum), int64(len(b))); got != sumBytes(want) {
+			t.Errorf("Poly = 0x%08x; CombineBytes(%x, %x, %d) = %x; want %x", p.poly, sumBytes(aSum), sumBytes(bSum), len(b), got, sumBytes(want))
+		}
+
+		if got := p.StripPrefix(want, a, int64(len(b))); got != bSum {
+			t.Errorf("Poly = 0x%08x; StripPrefix(0x%08x, a, %d) = 0x%08x; want 0x%08x", p.poly, want, len(b), got, bSum)
+		}
+	}
+}
+
+func sumBytes(sum uint32) [Size]byte {
+	var b [Size]byte
+	binary.BigEndian.PutUint32(b[:], sum)
+	return b
+}
+
+func TestUncombine(t *testing.T) {
+	for _, p := range polys {
+		if p.poly&(1<<(nBits-1)) == 0 {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("Poly = 0x%08x; Uncombine() didn't panic without an x^0 term", p.poly)
+					}
+				}()
+				p.Uncombine(1, 2, 1)
+			}()
+			continue
+		}
+		sum := p.Checksum([]byte("hello"))
+		if got := p.Uncombine(sum, 1, 0); got != sum {
+			t.Errorf("Poly = 0x%08x; Uncombine(0x%08x, 1, 0) = 0x%08x; want 0x%08x", p.poly, sum, got, sum)
+		}
+		if got := p.Uncombine(sum, sum, 5); got != 0 {
+			t.Errorf("Poly = 0x%08x; Uncombine(0x%08x, 0x%08x, 5) = 0x%08x; want 0", p.poly, sum, sum, got)
+		}
+	}
+}
+
+func TestExtendZeros(t *testing.T) {
+	for _, p := range polys {
+		for _, sum := range []uint32{0, p.Checksum([]byte("hello"))} {
+			for _, n := range []int64{-1, 0, 1, 2, 7, 8, 100, 1000, 4096} {
+				want := p.Update(sum, make([]byte, max(n, 0)))
+				if got := p.ExtendZeros(sum, n); got != want {
+					t.Errorf("Poly = 0x%08x; ExtendZeros(0x%08x, %d) = 0x%08x; want 0x%08x", p.poly, sum, n, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestResidue(t *testing.T) {
+	for _, p := range polys {
+		for _, size := range []int{0, 1, 9, 1000} {
+			h := NewLE(p)
+			h.Write(randData(size))
+			frame := h.Sum(randData(size))
+			if got, want := p.Checksum(frame), p.Residue(); got != want {
+				t.Errorf("Poly = 0x%08x; Checksum(%d bytes + LE checksum) = 0x%08x; want Residue() = 0x%08x", p.poly, size, got, want)
+			}
+		}
+	}
+}
+
+func TestExtendFiller(t *testing.T) {
+	for _, p := range polys {
+		for _, sum := range []uint32{0, p.Checksum([]byte("hello"))} {
+			for _, b := range []byte{0, 1, 0xff} {
+				for _, n := range []int64{-1, 0, 1, 2, 3, 7, 8, 100, 1000} {
+					want := p.Update(sum, bytes.Repeat([]byte{b}, int(max(n, 0))))
+					if got := p.ExtendFiller(sum, b, n); got != want {
+						t.Errorf("Poly = 0x%08x; ExtendFiller(0x%08x, 0x%02x, %d) = 0x%08x; want 0x%08x", p.poly, sum, b, n, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestAppendToForce(t *testing.T) {
+	for _, p := range polys {
+		if p.poly&(1<<(nBits-1)) == 0 {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("Poly = 0x%08x; AppendToForce() didn't panic without an x^0 term", p.poly)
+					}
+				}()
+				p.AppendToForce(nil, 1)
+			}()
+			continue
+		}
+		for _, data := range [][]byte{nil, []byte("123456789"), randData(1000)} {
+			for _, target := range []uint32{0, 1, p.Checksum(data), ^uint32(0)} {
+				b := p.AppendToForce(data, target)
+				if got := p.Checksum(append(data[:len(data):len(data)], b[:]...)); got != target {
+					t.Errorf("Poly = 0x%08x; Checksum(%d bytes + AppendToForce(..., 0x%08x)) = 0x%08x", p.poly, len(data), target, got)
+				}
+			}
+		}
+	}
+}
+
+func TestPatchToTarget(t *testing.T) {
+	for _, p := range polys {
+		data := randData(1000)
+		if p.poly&(1<<(nBits-1)) == 0 {
+			if err := p.PatchToTarget(data, 0, 1); err == nil {
+				t.Errorf("Poly = 0x%08x; PatchToTarget() without an x^0 term succeeded; want error", p.poly)
+			}
+			continue
+		}
+		for _, pos := range []int{0, 1, 500, len(data) - Size} {
+			for _, target := range []uint32{0, 1, ^uint32(0)} {
+				if err := p.PatchToTarget(data, pos, target); err != nil {
+					t.Fatalf("Poly = 0x%08x; PatchToTarget(%d, 0x%08x) failed: %v", p.poly, pos, target, err)
+				}
+				if got := p.Checksum(data); got != target {
+					t.Errorf("Poly = 0x%08x; Checksum() after PatchToTarget(%d, 0x%08x) = 0x%08x", p.poly, pos, target, got)
+				}
+			}
+		}
+		orig := bytes.Clone(data)
+		for _, pos := range []int{-1, len(data) - Size + 1} {
+			if err := p.PatchToTarget(data, pos, 0); err == nil || !bytes.Equal(data, orig) {
+				t.Errorf("Poly = 0x%08x; PatchToTarget(%d) = %v; want error without modification", p.poly, pos, err)
+			}
+		}
+	}
+}
+
+func TestStrictPolyChecks(t *testing.T) {
+	defer func(strict bool) { StrictPolyChecks = strict }(StrictPolyChecks)
+	StrictPolyChecks = true
+	if p := MakePoly(0xEDB88320); p.poly != 0xEDB88320 {
+		t.Errorf("MakePoly(0xEDB88320) = 0x%08x", p.poly)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MakePoly(0x04C11DB7) didn't panic with StrictPolyChecks")
+		}
+	}()
+	MakePoly(0x04C11DB7)
+}
+
+// updateBits is a bitwise reference that adds the first nbits bits of data,
+// least significant first, to the sum.
+func updateBits(p *Poly, sum uint32, data []byte, nbits int) uint32 {
+	crc := ^sum
+	for i := range nbits {
+		crc ^= uint32(data[i/8]>>(i%8)) & 1
+		if crc&1 != 0 {
+			crc = crc>>1 ^ p.poly
+		} else {
+			crc >>= 1
+		}
+	}
+	return ^crc
+}
+
+func TestCombineBits(t *testing.T) {
+	data := randData(64)
+	for _, p := range polys {
+		if got, want := updateBits(p, 0, data, 8*len(data)), p.Checksum(data); got != want {
+			t.Fatalf("Poly = 0x%08x; updateBits(%d bytes) = 0x%08x; want 0x%08x", p.poly, len(data), got, want)
+		}
+		for _, nbits := range []int{0, 1, 5, 8, 13, 100, 256} {
+			// Take the first 7 bits as prev and the following nbits as next.
+			prev := updateBits(p, 0, data, 7)
+			rest := make([]byte, len(data))
+			for i := range nbits {
+				j := i + 7
+				rest[i/8] |= (data[j/8] >> (j % 8) & 1) << (i % 8)
+			}
+			next := updateBits(p, 0, rest, nbits)
+			want := updateBits(p, 0, data, 7+nbits)
+			if got := p.CombineBits(prev, next, int64(nbits)); got != want {
+				t.Errorf("Poly = 0x%08x; CombineBits(0x%08x, 0x%08x, %d) = 0x%08x; want 0x%08x", p.poly, prev, next, nbits, got, want)
+			}
+		}
+		a, b := data[:10], data[10:]
+		if got, want := p.CombineBits(p.Checksum(a), p.Checksum(b), 8*int64(len(b))), p.Checksum(data); got != want {
+			t.Errorf("Poly = 0x%08x; CombineBits(bytes) = 0x%08x; want 0x%08x", p.poly, got, want)
+		}
 	}
 }
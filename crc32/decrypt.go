@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"crypto/cipher"
+	"errors"
+	"io"
+)
+
+// ChecksumDecrypting reads ciphertext from r until EOF, decrypts it in place with
+// the stream, and returns the CRC-32 checksum of the plaintext and the number
+// of bytes read. If reading fails, it returns the error along with the checksum and
+// length of the bytes read before it. The plaintext is cleared from memory before
+// it returns.
+func (p *Poly) ChecksumDecrypting(r io.Reader, stream cipher.Stream) (sum uint32, n int64, err error) {
+	buf := bufPool.Get().(*[]byte)
+	defer func() {
+		// Don't leave plaintext in the shared pool.
+		clear(*buf)
+		bufPool.Put(buf)
+	}()
+	for {
+		m, err := r.Read(*buf)
+		b := (*buf)[:m]
+		stream.XORKeyStream(b, b)
+		sum = p.Update(sum, b)
+		n += int64(m)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			return sum, n, err
+		}
+	}
+}
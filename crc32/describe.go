@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"fmt"
+	"hash/crc32"
+	"math/bits"
+	"strings"
+)
+
+// standardNames identify the named polynomials and their CRC RevEng catalogue entries.
+var standardNames = map[uint32]string{
+	crc32.IEEE:       "IEEE (CRC-32/ISO-HDLC)",
+	crc32.Castagnoli: "Castagnoli (CRC-32/ISCSI)",
+	crc32.Koopman:    "Koopman (CRC-32/KOOPMAN)",
+}
+
+// Describe returns a multi-line, human-readable description of the CRC's configuration:
+// its width, polynomial in normal and reversed forms, initial and final XOR values,
+// check value, residue, and the standard it matches, if any. The format is meant for
+// people and may change.
+func (p *Poly) Describe() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "width:    %d\n", nBits)
+	fmt.Fprintf(&b, "poly:     0x%08x (normal), 0x%08x (reversed)\n", bits.Reverse32(p.poly), p.poly)
+	fmt.Fprintf(&b, "init:     0x%08x\n", ^uint32(0))
+	fmt.Fprintf(&b, "xorout:   0x%08x\n", ^uint32(0))
+	fmt.Fprintf(&b, "check:    0x%08x\n", p.ChecksumString("123456789"))
+	fmt.Fprintf(&b, "residue:  0x%08x (little-endian appended checksum)\n", p.Residue())
+	if name, ok := standardNames[p.poly]; ok {
+		fmt.Fprintf(&b, "standard: %s\n", name)
+	} else {
+		b.WriteString("standard: none\n")
+	}
+	return b.String()
+}
+
+// String returns the polynomial in reversed representation, followed by its name
+// if it's a named polynomial, such as "crc32.Poly(0xedb88320, IEEE)".
+func (p *Poly) String() string {
+	if p == nil {
+		return "crc32.Poly(nil)"
+	}
+	if name, ok := standardNames[p.poly]; ok {
+		name, _, _ = strings.Cut(name, " ")
+		return fmt.Sprintf("crc32.Poly(0x%08x, %s)", p.poly, name)
+	}
+	return fmt.Sprintf("crc32.Poly(0x%08x)", p.poly)
+}
+
+// Equal reports whether p and q represent the same polynomial.
+// Two nil polys are equal, but a nil poly isn't equal to any other.
+func (p *Poly) Equal(q *Poly) bool {
+	if p == nil || q == nil {
+		return p == q
+	}
+	return p.poly == q.poly
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	got := IEEE().Describe()
+	for _, want := range []string{
+		"width:    32\n",
+		"poly:     0x04c11db7 (normal), 0xedb88320 (reversed)\n",
+		"check:    0xcbf43926\n",
+		"residue:  0x2144df1c (little-endian appended checksum)\n",
+		"standard: IEEE (CRC-32/ISO-HDLC)\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("IEEE().Describe() = %q; want it to contain %q", got, want)
+		}
+	}
+
+	for _, p := range polys {
+		// The residue is the checksum of any data followed by its little-endian checksum.
+		data := []byte("hello, world")
+		data = binary.LittleEndian.AppendUint32(data, p.Checksum(data))
+		want := fmt.Sprintf("residue:  0x%08x ", p.Checksum(data))
+		if got := p.Describe(); !strings.Contains(got, want) {
+			t.Errorf("Poly = 0x%08x; Describe() = %q; want it to contain %q", p.poly, got, want)
+		}
+	}
+	if got := MakePoly(1).Describe(); !strings.HasSuffix(got, "standard: none\n") {
+		t.Errorf("MakePoly(1).Describe() = %q; want no standard", got)
+	}
+}
+
+func TestPolyString(t *testing.T) {
+	tests := []struct {
+		poly *Poly
+		want string
+	}{
+		{IEEE(), "crc32.Poly(0xedb88320, IEEE)"},
+		{MakePoly(1), "crc32.Poly(0x00000001)"},
+		{nil, "crc32.Poly(nil)"},
+	}
+	for _, tt := range tests {
+		if got := tt.poly.String(); got != tt.want {
+			t.Errorf("String() = %q; want %q", got, tt.want)
+		}
+	}
+}
+
+func TestPolyEqual(t *testing.T) {
+	var null *Poly
+	tests := []struct {
+		p, q *Poly
+		want bool
+	}{
+		{IEEE(), IEEE(), true},
+		{IEEE(), makePoly(0xedb88320), true},
+		{IEEE(), MakePoly(1), false},
+		{IEEE(), nil, false},
+		{null, IEEE(), false},
+		{null, nil, true},
+	}
+	for _, tt := range tests {
+		if got := tt.p.Equal(tt.q); got != tt.want {
+			t.Errorf("%v.Equal(%v) = %v; want %v", tt.p, tt.q, got, tt.want)
+		}
+	}
+}
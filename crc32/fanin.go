@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import "fmt"
+
+// ChunkResult is the CRC-32 checksum of a chunk of a stream.
+type ChunkResult struct {
+	Index int    // position of the chunk in the stream, starting at zero
+	Sum   uint32 // checksum of the chunk
+	Len   int64  // length of the chunk
+}
+
+// FanInCombine receives chunk results until parts is closed and returns the checksum
+// of the chunks combined in order of their indexes, which may arrive in any order.
+// Chunks are combined as soon as they're next in order, so only chunks that arrive
+// early are buffered. Indexes must be unique and contiguous from zero. It returns an
+// error if an index is negative or duplicated, or if any are missing when parts is
+// closed, though it can't detect missing chunks at the end of the stream. It always
+// receives until parts is closed, so that senders don't block.
+func (p *Poly) FanInCombine(parts <-chan ChunkResult) (uint32, error) {
+	var sum uint32
+	var err error
+	next := 0
+	pending := make(map[int]ChunkResult)
+	for r := range parts {
+		if err != nil {
+			continue
+		}
+		if _, ok := pending[r.Index]; ok || r.Index < next {
+			if r.Index < 0 {
+				err = fmt.Errorf("crc32: invalid chunk index %d", r.Index)
+			} else {
+				err = fmt.Errorf("crc32: duplicate chunk index %d", r.Index)
+			}
+			continue
+		}
+		pending[r.Index] = r
+		for {
+			r, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			sum = p.Combine(sum, r.Sum, r.Len)
+			next++
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(pending) > 0 {
+		return 0, fmt.Errorf("crc32: missing chunk index %d", next)
+	}
+	return sum, nil
+}
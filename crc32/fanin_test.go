@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestFanInCombine(t *testing.T) {
+	data := randData(10000)
+	const chunkSize = 333
+	var chunks []ChunkResult
+	for i := 0; i*chunkSize < len(data); i++ {
+		chunk := data[i*chunkSize : min((i+1)*chunkSize, len(data))]
+		chunks = append(chunks, ChunkResult{Index: i, Len: int64(len(chunk))})
+	}
+	rng := rand.New(rand.NewSource(1))
+	for _, p := range polys {
+		for i := range chunks {
+			start := i * chunkSize
+			chunks[i].Sum = p.Checksum(data[start : start+int(chunks[i].Len)])
+		}
+		order := rng.Perm(len(chunks))
+		parts := make(chan ChunkResult)
+		var wg sync.WaitGroup
+		for _, i := range order {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				parts <- chunks[i]
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(parts)
+		}()
+		if got, err := p.FanInCombine(parts); err != nil || got != p.Checksum(data) {
+			t.Errorf("Poly = 0x%08x; FanInCombine(shuffled) = (0x%08x, %v); want (0x%08x, nil)", p.poly, got, err, p.Checksum(data))
+		}
+
+		// A missing chunk, a duplicate chunk, or an invalid index is an error.
+		for name, indexes := range map[string][]int{
+			"missing":   slices.DeleteFunc(slices.Clone(order), func(i int) bool { return i == 3 }),
+			"duplicate": slices.Concat(order, []int{len(chunks) - 1}),
+			"repeated":  slices.Concat(order, []int{0}),
+			"negative":  slices.Concat(order, []int{-1}),
+		} {
+			bad := make(chan ChunkResult, len(indexes))
+			for _, i := range indexes {
+				if i < 0 {
+					bad <- ChunkResult{Index: i}
+				} else {
+					bad <- chunks[i]
+				}
+			}
+			close(bad)
+			if got, err := p.FanInCombine(bad); err == nil {
+				t.Errorf("Poly = 0x%08x; FanInCombine(%s) = (0x%08x, nil); want error", p.poly, name, got)
+			}
+		}
+
+		empty := make(chan ChunkResult)
+		close(empty)
+		if got, err := p.FanInCombine(empty); err != nil || got != 0 {
+			t.Errorf("Poly = 0x%08x; FanInCombine(empty) = (0x%08x, %v); want (0, nil)", p.poly, got, err)
+		}
+	}
+}
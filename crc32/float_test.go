@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func TestChecksumFloat64(t *testing.T) {
+	var (
+		negZero = math.Copysign(0, -1)
+		nan1    = math.Float64frombits(0x7ff8000000000001)
+		nan2    = math.Float64frombits(0xfff0000000000002)
+	)
+	for _, p := range polys {
+		var buf []byte
+		for _, v := range []float64{1.5, -2, math.Inf(1)} {
+			buf = binary.BigEndian.AppendUint64(buf, math.Float64bits(v))
+		}
+		if got, want := p.ChecksumFloat64(1.5, -2, math.Inf(1)), p.Checksum(buf); got != want {
+			t.Errorf("Poly = 0x%08x; ChecksumFloat64(1.5, -2, +Inf) = 0x%08x; want 0x%08x", p.poly, got, want)
+		}
+		if a, b := p.ChecksumFloat64(negZero), p.ChecksumFloat64(0); a != b {
+			t.Errorf("Poly = 0x%08x; ChecksumFloat64(-0) = 0x%08x; want 0x%08x", p.poly, a, b)
+		}
+		if a, b := p.ChecksumFloat64(nan1), p.ChecksumFloat64(nan2); a != b {
+			t.Errorf("Poly = 0x%08x; ChecksumFloat64(NaN) = 0x%08x and 0x%08x; want equal", p.poly, a, b)
+		}
+		if a, b := p.ChecksumFloat64(nan1), p.ChecksumFloat64(0); a == b {
+			t.Errorf("Poly = 0x%08x; ChecksumFloat64(NaN) = ChecksumFloat64(0) = 0x%08x; want different", p.poly, a)
+		}
+	}
+}
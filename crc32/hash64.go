@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+// Hash64From32 returns a 64-bit hash of data with the CRC-32 checksum using the
+// [Castagnoli] polynomial in the high 32 bits and the one using the [IEEE] polynomial
+// in the low 32 bits, both of which may be hardware accelerated. It's less likely
+// to collide than either checksum alone, but it isn't a CRC-64 checksum, it can't
+// be combined, and it isn't suitable for adversarial inputs.
+func Hash64From32(data []byte) uint64 {
+	return uint64(Castagnoli().Checksum(data))<<32 | uint64(IEEE().Checksum(data))
+}
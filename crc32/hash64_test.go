@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"testing"
+)
+
+func TestHash64From32(t *testing.T) {
+	data := []byte("123456789")
+	if got, want := Hash64From32(data), uint64(0xe3069283cbf43926); got != want {
+		t.Errorf("Hash64From32(%q) = 0x%016x; want 0x%016x", data, got, want)
+	}
+	if a, b := Hash64From32(data), Hash64From32(data); a != b {
+		t.Errorf("Hash64From32(%q) = 0x%016x and 0x%016x; want equal", data, a, b)
+	}
+
+	// Each output bit should be set for about half of the sequential keys.
+	const n = 1 << 12
+	var counts [64]int
+	seen := make(map[uint64]bool, n)
+	for i := range n {
+		h := Hash64From32(binary.BigEndian.AppendUint64(nil, uint64(i)))
+		if seen[h] {
+			t.Fatalf("Hash64From32(%d) collided", i)
+		}
+		seen[h] = true
+		for ; h != 0; h &= h - 1 {
+			counts[bits.TrailingZeros64(h)]++
+		}
+	}
+	for bit, c := range counts {
+		if c < n*4/10 || c > n*6/10 {
+			t.Errorf("Hash64From32 set bit %d for %d of %d keys; want about half", bit, c, n)
+		}
+	}
+}
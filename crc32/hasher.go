@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+// Hasher32 computes a CRC-32 checksum incrementally. Unlike [Hash], it's a concrete
+// value type, so it may be allocated on the stack and its methods may be inlined.
+// A Hasher32 must be created by [Poly.Hasher].
+type Hasher32 struct {
+	poly *Poly
+	sum  uint32
+}
+
+// Hasher returns a new [Hasher32] computing the CRC-32 checksum using the polynomial
+// represented by the [Poly].
+func (p *Poly) Hasher() Hasher32 {
+	return Hasher32{poly: p}
+}
+
+// Write adds the bytes in data to the running checksum. It never returns an error.
+func (h *Hasher32) Write(data []byte) (int, error) {
+	h.sum = h.poly.Update(h.sum, data)
+	return len(data), nil
+}
+
+// Sum32 returns the running checksum.
+func (h *Hasher32) Sum32() uint32 {
+	return h.sum
+}
+
+// Reset resets the running checksum to its initial state.
+func (h *Hasher32) Reset() {
+	h.sum = 0
+}
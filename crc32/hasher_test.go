@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"io"
+	"testing"
+)
+
+var _ io.Writer = (*Hasher32)(nil)
+
+func TestHasher(t *testing.T) {
+	a, b := []byte("hello, "), []byte("world")
+	for _, p := range polys {
+		want := p.Update(p.Checksum(a), b)
+		h := p.Hasher()
+		h.Write(a)
+		h.Write(b)
+		if got := h.Sum32(); got != want {
+			t.Errorf("Poly = 0x%08x; Hasher.Sum32() = 0x%08x; want 0x%08x", p.poly, got, want)
+		}
+		h.Reset()
+		h.Write(b)
+		if got, want := h.Sum32(), p.Checksum(b); got != want {
+			t.Errorf("Poly = 0x%08x; Hasher.Sum32() after Reset = 0x%08x; want 0x%08x", p.poly, got, want)
+		}
+	}
+}
+
+var benchMsgs = func() [][]byte {
+	msgs := make([][]byte, 64)
+	for i := range msgs {
+		msgs[i] = make([]byte, 16+i)
+	}
+	return msgs
+}()
+
+var benchSum uint32
+
+func BenchmarkHasher(b *testing.B) {
+	p := IEEE()
+	b.ReportAllocs()
+	for range b.N {
+		for _, msg := range benchMsgs {
+			h := p.Hasher()
+			h.Write(msg)
+			benchSum = h.Sum32()
+		}
+	}
+}
+
+func BenchmarkHash(b *testing.B) {
+	p := IEEE()
+	b.ReportAllocs()
+	for range b.N {
+		for _, msg := range benchMsgs {
+			h := New(p)
+			h.Write(msg)
+			benchSum = h.Sum32()
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"hash/fnv"
+	"io"
+	"math/rand"
+	"testing"
+	"testing/iotest"
+)
+
+func TestChecksumMmap(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range []int{0, 1, bufSize - 1, bufSize, 3*bufSize + 7} {
+		data := make([]byte, size)
+		r.Read(data)
+		for _, p := range polys {
+			want := p.Checksum(data)
+			got, err := p.ChecksumMmap(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("Poly = 0x%08x; ChecksumMmap() failed: %v", p.poly, err)
+			}
+			if got != want {
+				t.Errorf("Poly = 0x%08x; ChecksumMmap() of %d bytes = 0x%08x; want 0x%08x", p.poly, size, got, want)
+			}
+		}
+	}
+}
+
+func TestVerifyWhileReading(t *testing.T) {
+	data := randData(3*bufSize + 7)
+	errRead := errors.New("read failed")
+	for _, p := range polys {
+		sum := p.Checksum(data)
+		tests := []struct {
+			name string
+			r    io.Reader
+			want uint32
+			ok   bool
+			n    int64
+			err  error
+		}{
+			{"match", bytes.NewReader(data), sum, true, int64(len(data)), nil},
+			{"mismatch", bytes.NewReader(data), sum ^ 1, false, int64(len(data)), nil},
+			{"empty", bytes.NewReader(nil), 0, true, 0, nil},
+			{"error", io.MultiReader(bytes.NewReader(data[:10]), iotest.ErrReader(errRead)), p.Checksum(data[:10]), false, 10, errRead},
+		}
+		for _, tt := range tests {
+			ok, n, err := p.VerifyWhileReading(tt.r, tt.want)
+			if ok != tt.ok || n != tt.n || err != tt.err {
+				t.Errorf("Poly = 0x%08x; %s: VerifyWhileReading() = (%v, %d, %v); want (%v, %d, %v)", p.poly, tt.name, ok, n, err, tt.ok, tt.n, tt.err)
+			}
+		}
+	}
+}
+
+func TestFirstDifferentBlock(t *testing.T) {
+	const blockSize = 64
+	data := randData(10 * blockSize)
+	changed := bytes.Clone(data)
+	changed[3*blockSize+5] ^= 1
+	errRead := errors.New("read failed")
+	tests := []struct {
+		name string
+		a, b []byte
+		want int64
+	}{
+		{"identical", data, data, -1},
+		{"empty", nil, nil, -1},
+		{"changed", data, changed, 3 * blockSize},
+		{"shorter", data, data[:4*blockSize+1], -1},
+		{"shorter changed", changed, data[:3*blockSize+6], 3 * blockSize},
+		{"shorter unchanged", changed, data[:3*blockSize+5], -1},
+	}
+	for _, p := range polys {
+		for _, tt := range tests {
+			got, err := p.FirstDifferentBlock(bytes.NewReader(tt.a), bytes.NewReader(tt.b), blockSize)
+			if err != nil || got != tt.want {
+				t.Errorf("Poly = 0x%08x; %s: FirstDifferentBlock() = (%d, %v); want (%d, nil)", p.poly, tt.name, got, err, tt.want)
+			}
+		}
+		if _, err := p.FirstDifferentBlock(bytes.NewReader(data), iotest.ErrReader(errRead), blockSize); err != errRead {
+			t.Errorf("Poly = 0x%08x; FirstDifferentBlock() error = %v; want %v", p.poly, err, errRead)
+		}
+	}
+}
+
+func TestChecksumPipe(t *testing.T) {
+	data := randData(3*bufSize + 7)
+	errWrite := errors.New("write failed")
+	for _, p := range polys {
+		for _, werr := range []error{nil, errWrite} {
+			pr, pw := io.Pipe()
+			done := make(chan error)
+			go func() {
+				_, err := pw.Write(data)
+				pw.CloseWithError(werr)
+				done <- err
+			}()
+			sum, n, err := p.ChecksumPipe(pr)
+			if err != werr {
+				t.Errorf("Poly = 0x%08x; ChecksumPipe() error = %v; want %v", p.poly, err, werr)
+			}
+			if want := p.Checksum(data); sum != want || n != int64(len(data)) {
+				t.Errorf("Poly = 0x%08x; ChecksumPipe() = (0x%08x, %d); want (0x%08x, %d)", p.poly, sum, n, want, len(data))
+			}
+			if err := <-done; err != nil {
+				t.Errorf("Poly = 0x%08x; PipeWriter.Write() failed: %v", p.poly, err)
+			}
+		}
+	}
+}
+
+func TestChecksumReader(t *testing.T) {
+	data := randData(3*bufSize + 11)
+	for _, p := range polys {
+		for _, size := range []int{0, 1, bufSize, len(data)} {
+			sum, n, err := p.ChecksumReader(iotest.HalfReader(bytes.NewReader(data[:size])))
+			if want := p.Checksum(data[:size]); err != nil || sum != want || n != int64(size) {
+				t.Errorf("Poly = 0x%08x; ChecksumReader(%d bytes) = (0x%08x, %d, %v); want (0x%08x, %d, nil)", p.poly, size, sum, n, err, want, size)
+			}
+		}
+		r := io.MultiReader(bytes.NewReader(data[:100]), iotest.ErrReader(errLimit))
+		sum, n, err := p.ChecksumReader(r)
+		if want := p.Checksum(data[:100]); !errors.Is(err, errLimit) || sum != want || n != 100 {
+			t.Errorf("Poly = 0x%08x; ChecksumReader(failing) = (0x%08x, %d, %v); want (0x%08x, 100, %v)", p.poly, sum, n, err, want, errLimit)
+		}
+	}
+}
+
+func TestChecksumReaderBuf(t *testing.T) {
+	data := randData(3*bufSize + 11)
+	for _, p := range polys {
+		want, wantN := p.Checksum(data), int64(len(data))
+		for _, size := range []int{1, 100, bufSize, 2 * len(data)} {
+			sum, n, err := p.ChecksumReaderBuf(bytes.NewReader(data), make([]byte, size))
+			if err != nil || sum != want || n != wantN {
+				t.Errorf("Poly = 0x%08x; ChecksumReaderBuf(%d-byte buf) = (0x%08x, %d, %v); want (0x%08x, %d, nil)", p.poly, size, sum, n, err, want, wantN)
+			}
+		}
+		if _, _, err := p.ChecksumReaderBuf(bytes.NewReader(data), nil); err == nil {
+			t.Errorf("Poly = 0x%08x; ChecksumReaderBuf(nil buf) succeeded; want error", p.poly)
+		}
+	}
+}
+
+func TestChecksumAlongside(t *testing.T) {
+	data := randData(3*bufSize + 11)
+	wantSHA := sha256.Sum256(data)
+	for _, p := range polys {
+		h, f := sha256.New(), fnv.New64a()
+		sum, n, err := p.ChecksumAlongside(iotest.HalfReader(bytes.NewReader(data)), h, f)
+		if err != nil {
+			t.Fatalf("Poly = 0x%08x; ChecksumAlongside() failed: %v", p.poly, err)
+		}
+		if want := p.Checksum(data); sum != want || n != int64(len(data)) {
+			t.Errorf("Poly = 0x%08x; ChecksumAlongside() = (0x%08x, %d); want (0x%08x, %d)", p.poly, sum, n, want, len(data))
+		}
+		if got := h.Sum(nil); !bytes.Equal(got, wantSHA[:]) {
+			t.Errorf("Poly = 0x%08x; ChecksumAlongside() sha256 = %x; want %x", p.poly, got, wantSHA)
+		}
+		wantFNV := fnv.New64a()
+		wantFNV.Write(data)
+		if got, want := f.Sum64(), wantFNV.Sum64(); got != want {
+			t.Errorf("Poly = 0x%08x; ChecksumAlongside() fnv = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+		if sum, _, err := p.ChecksumAlongside(bytes.NewReader(data)); err != nil || sum != p.Checksum(data) {
+			t.Errorf("Poly = 0x%08x; ChecksumAlongside() without extra = (0x%08x, %v)", p.poly, sum, err)
+		}
+	}
+}
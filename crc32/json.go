@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ChecksumJSONStream reads a JSON array from r and calls yield with the raw bytes of
+// each element and their CRC-32 checksum, without decoding the whole array at once.
+// It stops early without error if yield returns false.
+//
+// The raw bytes are only valid until yield returns, as their buffer is reused.
+func (p *Poly) ChecksumJSONStream(r io.Reader, yield func(raw json.RawMessage, crc uint32) bool) error {
+	dec := json.NewDecoder(r)
+	if tok, err := dec.Token(); err != nil {
+		return err
+	} else if tok != json.Delim('[') {
+		return fmt.Errorf("crc32: JSON stream starts with %v; want array", tok)
+	}
+	var raw json.RawMessage
+	for dec.More() {
+		if err := dec.Decode(&raw); err != nil {
+			return err
+		}
+		if !yield(raw, p.Checksum(raw)) {
+			return nil
+		}
+	}
+	_, err := dec.Token() // Closing delimiter.
+	return err
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestChecksumJSONStream(t *testing.T) {
+	const stream = `[
+		{"id": 1, "msg": "hello"},
+		{"id": 2, "tags": ["a", "b"]},
+		"text",
+		42
+	]`
+	want := []string{`{"id": 1, "msg": "hello"}`, `{"id": 2, "tags": ["a", "b"]}`, `"text"`, `42`}
+	for _, p := range polys {
+		var got []string
+		err := p.ChecksumJSONStream(strings.NewReader(stream), func(raw json.RawMessage, crc uint32) bool {
+			if want := p.Checksum(raw); crc != want {
+				t.Errorf("Poly = 0x%08x; ChecksumJSONStream() yielded %s with 0x%08x; want 0x%08x", p.poly, raw, crc, want)
+			}
+			got = append(got, string(raw))
+			return true
+		})
+		if err != nil {
+			t.Fatalf("Poly = 0x%08x; ChecksumJSONStream() failed: %v", p.poly, err)
+		}
+		if strings.Join(got, "\n") != strings.Join(want, "\n") {
+			t.Errorf("Poly = 0x%08x; ChecksumJSONStream() yielded %q; want %q", p.poly, got, want)
+		}
+
+		n := 0
+		err = p.ChecksumJSONStream(strings.NewReader(stream), func(json.RawMessage, uint32) bool {
+			n++
+			return false
+		})
+		if err != nil || n != 1 {
+			t.Errorf("Poly = 0x%08x; ChecksumJSONStream() stopped after %d elements with error %v; want 1 and nil", p.poly, n, err)
+		}
+	}
+
+	for _, stream := range []string{``, `{}`, `[1, 2`, `[1 2]`} {
+		if err := polys[0].ChecksumJSONStream(strings.NewReader(stream), func(json.RawMessage, uint32) bool { return true }); err == nil {
+			t.Errorf("ChecksumJSONStream(%q) succeeded; want error", stream)
+		}
+	}
+}
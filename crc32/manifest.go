@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// A Manifest records the CRC-32 checksums of files for fixity checking,
+// in the style of a BagIt payload manifest.
+//
+// Its text form has one line per file, sorted by path, containing the checksum
+// as 8 hexadecimal digits and the path separated by two spaces.
+type Manifest struct {
+	poly *Poly
+	sums map[string]uint32
+}
+
+// NewManifest returns a new empty [Manifest] of CRC-32 checksums using
+// the polynomial represented by the [Poly].
+func NewManifest(p *Poly) *Manifest {
+	return &Manifest{poly: p, sums: make(map[string]uint32)}
+}
+
+// Add records the checksum of the file at path, replacing any previous checksum.
+func (m *Manifest) Add(path string, sum uint32) {
+	m.sums[path] = sum
+}
+
+// Paths returns the sorted paths of the files in the manifest.
+func (m *Manifest) Paths() []string {
+	paths := make([]string, 0, len(m.sums))
+	for path := range m.sums {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// Verify checks the contents of the files in fsys against the manifest and returns
+// the sorted paths of files whose checksums don't match or which don't exist.
+// It returns an error if any other error occurs while reading a file.
+func (m *Manifest) Verify(fsys fs.FS) ([]string, error) {
+	var failed []string
+	for _, path := range m.Paths() {
+		f, err := fsys.Open(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			failed = append(failed, path)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sum, _, err := m.poly.ChecksumReader(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("crc32: reading %s: %w", path, err)
+		}
+		if sum != m.sums[path] {
+			failed = append(failed, path)
+		}
+	}
+	return failed, nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (m *Manifest) MarshalText() ([]byte, error) {
+	var b []byte
+	for _, path := range m.Paths() {
+		if strings.ContainsAny(path, "\r\n") {
+			return nil, fmt.Errorf("crc32: manifest path contains a newline: %q", path)
+		}
+		b = fmt.Appendf(b, "%08x  %s\n", m.sums[path], path)
+	}
+	return b, nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler]. The entries are added to the manifest.
+func (m *Manifest) UnmarshalText(text []byte) error {
+	sc := bufio.NewScanner(bytes.NewReader(text))
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		hex, path, ok := strings.Cut(line, " ")
+		path = strings.TrimLeft(path, " \t")
+		if !ok || len(hex) != 2*Size || path == "" {
+			return fmt.Errorf("crc32: invalid manifest line %d: %q", n, line)
+		}
+		sum, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return fmt.Errorf("crc32: invalid manifest line %d: %q", n, line)
+		}
+		m.Add(path, uint32(sum))
+	}
+	return sc.Err()
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+func TestManifest(t *testing.T) {
+	p := IEEE()
+	fsys := fstest.MapFS{
+		"a.txt":               {Data: []byte("alpha")},
+		"data/b.bin":          {Data: randData(1000)},
+		"data/with space.txt": {Data: []byte("gamma")},
+	}
+	m := NewManifest(p)
+	for path, f := range fsys {
+		m.Add(path, p.Checksum(f.Data))
+	}
+	text, err := m.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() failed: %v", err)
+	}
+	loaded := NewManifest(p)
+	if err := loaded.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText() failed: %v", err)
+	}
+	if got, want := loaded.Paths(), m.Paths(); !slices.Equal(got, want) {
+		t.Fatalf("UnmarshalText(%q).Paths() = %q; want %q", text, got, want)
+	}
+
+	if failed, err := loaded.Verify(fsys); err != nil || len(failed) != 0 {
+		t.Errorf("Verify() = (%q, %v); want (nil, nil)", failed, err)
+	}
+	fsys["data/b.bin"].Data[10] ^= 1
+	delete(fsys, "a.txt")
+	want := []string{"a.txt", "data/b.bin"}
+	if failed, err := loaded.Verify(fsys); err != nil || !slices.Equal(failed, want) {
+		t.Errorf("Verify() = (%q, %v); want (%q, nil)", failed, err, want)
+	}
+
+	for _, text := range []string{"xyz  a.txt\n", "0123456  a.txt\n", "01234567\n", "01234567  \n"} {
+		if err := NewManifest(p).UnmarshalText([]byte(text)); err == nil {
+			t.Errorf("UnmarshalText(%q) succeeded; want error", text)
+		}
+	}
+}
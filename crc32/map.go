@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import "slices"
+
+// ChecksumStringMap returns a CRC-32 checksum of the entries in m that's independent
+// of the map's iteration order.
+//
+// The keys are sorted and each key and its value are hashed in turn, each prefixed by
+// its length as an 8-byte big-endian integer. The length prefixes keep the boundaries
+// between strings unambiguous, so {"a": "bc"} and {"ab": "c"} have different checksums
+// even though their concatenated keys and values are identical.
+func (p *Poly) ChecksumStringMap(m map[string]string) uint32 {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	parts := make([][]byte, 0, 2*len(keys))
+	for _, k := range keys {
+		parts = append(parts, []byte(k), []byte(m[k]))
+	}
+	return p.hashParts(parts...)
+}
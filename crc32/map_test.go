@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestChecksumStringMap(t *testing.T) {
+	for _, p := range polys {
+		// Maps built in opposite insertion orders.
+		fwd, rev := make(map[string]string), make(map[string]string)
+		for i := range 64 {
+			fwd[fmt.Sprint("key", i)] = fmt.Sprint("value", i)
+			rev[fmt.Sprint("key", 63-i)] = fmt.Sprint("value", 63-i)
+		}
+		want := p.ChecksumStringMap(fwd)
+		for range 8 {
+			if got := p.ChecksumStringMap(rev); got != want {
+				t.Errorf("Poly = 0x%08x; ChecksumStringMap() = 0x%08x; want 0x%08x", p.poly, got, want)
+			}
+		}
+
+		maps := []map[string]string{
+			nil,
+			{"": ""},
+			{"a": "bc"},
+			{"ab": "c"},
+			{"abc": ""},
+			{"a": "", "bc": ""},
+			{"a": "b", "c": ""},
+		}
+		seen := make(map[uint32]map[string]string)
+		for _, m := range maps {
+			sum := p.ChecksumStringMap(m)
+			if prev, ok := seen[sum]; ok {
+				t.Errorf("Poly = 0x%08x; ChecksumStringMap(%q) = ChecksumStringMap(%q) = 0x%08x", p.poly, m, prev, sum)
+			}
+			seen[sum] = m
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"errors"
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+func TestModelCheck(t *testing.T) {
+	// Check values are published in the CRC RevEng catalogue.
+	tests := []struct {
+		name  string
+		model Model
+		want  uint32
+	}{
+		{"CRC-32/ISO-HDLC", Model{Width: nBits, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff}, 0xcbf43926},
+		{"CRC-32/BZIP2", Model{Width: nBits, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: false, RefOut: false, XorOut: 0xffffffff}, 0xfc891918},
+		{"CRC-32/MPEG-2", Model{Width: nBits, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: false, RefOut: false, XorOut: 0x00000000}, 0x0376e6e7},
+		{"CRC-32/CKSUM", Model{Width: nBits, Poly: 0x04c11db7, Init: 0x00000000, RefIn: false, RefOut: false, XorOut: 0xffffffff}, 0x765e7680},
+		{"CRC-32/JAMCRC", Model{Width: nBits, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0x00000000}, 0x340bc6d9},
+		{"CRC-32/ISCSI", Model{Width: nBits, Poly: 0x1edc6f41, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff}, 0xe3069283},
+	}
+	for _, tt := range tests {
+		if got := tt.model.Check(); got != tt.want {
+			t.Errorf("%s: Check() = 0x%08x; want 0x%08x", tt.name, got, tt.want)
+		}
+	}
+}
+
+// modelBits is a bitwise reference that follows the model's definition directly.
+func modelBits(m Model, data []byte) uint32 {
+	crc := m.Init
+	for _, v := range data {
+		if m.RefIn {
+			v = bits.Reverse8(v)
+		}
+		crc ^= uint32(v) << (nBits - 8)
+		for range 8 {
+			if crc&(uint32(1)<<(nBits-1)) != 0 {
+				crc = crc<<1 ^ m.Poly
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	if m.RefOut {
+		crc = bits.Reverse32(crc)
+	}
+	return crc ^ m.XorOut
+}
+
+func TestModel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	data := randData(300)
+	for range 32 {
+		m := Model{
+			Width:  nBits,
+			Poly:   uint32(r.Uint64()) | 1,
+			Init:   uint32(r.Uint64()),
+			RefIn:  r.Intn(2) == 0,
+			RefOut: r.Intn(2) == 0,
+			XorOut: uint32(r.Uint64()),
+		}
+		want := modelBits(m, data)
+		if got := m.Checksum(data); got != want {
+			t.Errorf("Model = %+v; Checksum() = 0x%08x; want 0x%08x", m, got, want)
+		}
+
+		h := m.New()
+		h.Write(data[:100])
+		state, err := h.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Model = %+v; MarshalBinary() failed: %v", m, err)
+		}
+		resumed := m.New()
+		if err := resumed.UnmarshalBinary(state); err != nil {
+			t.Fatalf("Model = %+v; UnmarshalBinary() failed: %v", m, err)
+		}
+		resumed.Write(data[100:])
+		if got := resumed.Sum32(); got != want {
+			t.Errorf("Model = %+v; resumed Sum32() = 0x%08x; want 0x%08x", m, got, want)
+		}
+
+		other := m
+		other.RefOut = !other.RefOut
+		if err := other.New().UnmarshalBinary(state); !errors.Is(err, ErrStateMismatch) {
+			t.Errorf("Model = %+v; UnmarshalBinary() of another model's state error = %v; want %v", m, err, ErrStateMismatch)
+		}
+		if err := m.New().UnmarshalBinary(state[:len(state)-1]); !errors.Is(err, ErrInvalidState) {
+			t.Errorf("Model = %+v; UnmarshalBinary() of truncated state error = %v; want %v", m, err, ErrInvalidState)
+		}
+	}
+}
+
+func TestModelMakePoly(t *testing.T) {
+	for _, poly := range []uint32{0x04c11db7, 0x814141ab} {
+		a := Model{Width: nBits, Poly: poly, RefIn: true, RefOut: true}
+		b := Model{Width: nBits, Poly: poly, Init: ^uint32(0), XorOut: ^uint32(0)}
+		if a.MakePoly() != b.MakePoly() {
+			t.Errorf("Poly = 0x%08x; models don't share a Poly", poly)
+		}
+		if got, want := a.MakePoly().poly, bits.Reverse32(poly); got != want {
+			t.Errorf("Poly = 0x%08x; MakePoly().poly = 0x%08x; want 0x%08x", poly, got, want)
+		}
+		for _, m := range []Model{a, b} {
+			if m.New().(*modelDigest).poly != a.MakePoly() {
+				t.Errorf("Model = %+v; New() doesn't use the shared Poly", m)
+			}
+		}
+	}
+	if m, ok := Lookup("CRC-32/BZIP2"); !ok || m.MakePoly() != IEEE() {
+		t.Errorf("Lookup(%q).MakePoly() isn't IEEE()", "CRC-32/BZIP2")
+	}
+}
+
+func TestModelWidth(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Model{}.New() didn't panic with an invalid width")
+		}
+	}()
+	Model{}.New()
+}
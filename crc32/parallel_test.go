@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func randData(size int) []byte {
+	b := make([]byte, size)
+	rand.New(rand.NewSource(42)).Read(b)
+	return b
+}
+
+func TestChecksumParallelContext(t *testing.T) {
+	data := randData(4*minShardSize + 13)
+	for _, p := range polys {
+		for _, size := range []int{0, 1, minShardSize, 2*minShardSize + 1, len(data)} {
+			want := p.Checksum(data[:size])
+			for _, shards := range []int{-1, 0, 1, 2, 3, 7} {
+				got, err := p.ChecksumParallelContext(context.Background(), data[:size], shards)
+				if err != nil || got != want {
+					t.Errorf("Poly = 0x%08x; ChecksumParallelContext(%d bytes, %d shards) = (0x%08x, %v); want 0x%08x", p.poly, size, shards, got, err, want)
+				}
+			}
+		}
+	}
+}
+
+func TestChecksumParallel(t *testing.T) {
+	data := randData(4*minShardSize + 13)
+	for _, p := range polys {
+		for _, size := range []int{0, 1, minShardSize, 2*minShardSize + 1, len(data)} {
+			want := p.Checksum(data[:size])
+			for _, shards := range []int{-1, 0, 1, 2, 3, 7} {
+				if got := p.ChecksumParallel(data[:size], shards); got != want {
+					t.Errorf("Poly = 0x%08x; ChecksumParallel(%d bytes, %d shards) = 0x%08x; want 0x%08x", p.poly, size, shards, got, want)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkChecksumParallel(b *testing.B) {
+	data := randData(64 << 20)
+	for _, shards := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			p := IEEE()
+			b.SetBytes(int64(len(data)))
+			for range b.N {
+				benchSum = p.ChecksumParallel(data, shards)
+			}
+		})
+	}
+}
+
+func TestChecksumParallelContextCancel(t *testing.T) {
+	p := polys[0]
+	data := randData(64 << 20)
+	want := p.Checksum(data)
+	baseline := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := p.ChecksumParallelContext(ctx, data, 8); !errors.Is(err, context.Canceled) {
+		t.Errorf("ChecksumParallelContext(canceled) error = %v; want %v", err, context.Canceled)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond)
+	if got, err := p.ChecksumParallelContext(ctx, data, 8); err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("ChecksumParallelContext(timeout) error = %v; want %v", err, context.DeadlineExceeded)
+	} else if err == nil && got != want {
+		t.Errorf("ChecksumParallelContext(timeout) = 0x%08x; want 0x%08x", got, want)
+	}
+	cancel()
+
+	// The context's timer and the runtime may take a moment to release their goroutines.
+	deadline := time.Now().Add(5 * time.Second)
+	n := runtime.NumGoroutine()
+	for n > baseline && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		n = runtime.NumGoroutine()
+	}
+	if n > baseline {
+		t.Errorf("NumGoroutine() = %d; want %d", n, baseline)
+	}
+}
+
+// expiringContext is done once Err has been called a number of times.
+type expiringContext struct {
+	context.Context
+	calls int
+}
+
+func (ctx *expiringContext) Err() error {
+	if ctx.calls--; ctx.calls < 0 {
+		return context.DeadlineExceeded
+	}
+	return nil
+}
+
+func TestChecksumContextCompleted(t *testing.T) {
+	// A deadline passing after the last chunk is hashed isn't an error.
+	p := polys[0]
+	data := randData(3 * ctxChunkSize)
+	ctx := &expiringContext{Context: context.Background(), calls: 3}
+	if got, err := p.checksumContext(ctx, data); err != nil || got != p.Checksum(data) {
+		t.Errorf("checksumContext() = (0x%08x, %v); want (0x%08x, nil)", got, err, p.Checksum(data))
+	}
+}
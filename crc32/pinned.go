@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// The pinned state is laid out as a magic identifier, a version,
+// the polynomial, and the current sum, all in big-endian byte order.
+const (
+	pinnedMagic   = "crcP"
+	pinnedVersion = 1
+	pinnedLen     = len(pinnedMagic) + 1 + 2*Size
+)
+
+type pinnedDigest struct {
+	poly *Poly
+	tbl  *[256]uint32
+	crc  uint32
+}
+
+// pinnedTable returns a table for the polynomial computed bit by bit,
+// without the standard library.
+func pinnedTable(poly uint32) *[256]uint32 {
+	tbl := new([256]uint32)
+	for i := range tbl {
+		crc := uint32(i)
+		for range 8 {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ poly
+			} else {
+				crc >>= 1
+			}
+		}
+		tbl[i] = crc
+	}
+	return tbl
+}
+
+// PinnedNew creates a new [Hash] computing the CRC-32 checksum using the polynomial
+// represented by the [Poly]. Unlike [New], it doesn't depend on the standard library's
+// implementation, as it computes its own table, and its marshaled state has a versioned format that will remain
+// readable by future versions of this package, so it's suitable for long-lived
+// checkpoints. It's slower than [New], which may be hardware accelerated.
+// Its UnmarshalBinary method returns errors that wrap [ErrInvalidState]
+// or [ErrStateMismatch] to describe why state was rejected.
+func (p *Poly) PinnedNew() Hash {
+	return &pinnedDigest{poly: p, tbl: pinnedTable(p.poly)}
+}
+
+func (d *pinnedDigest) Size() int { return Size }
+
+func (d *pinnedDigest) BlockSize() int { return 1 }
+
+func (d *pinnedDigest) Reset() { d.crc = 0 }
+
+func (d *pinnedDigest) Write(b []byte) (int, error) {
+	tbl := d.tbl
+	crc := ^d.crc
+	for _, v := range b {
+		crc = tbl[byte(crc)^v] ^ (crc >> 8)
+	}
+	d.crc = ^crc
+	return len(b), nil
+}
+
+func (d *pinnedDigest) Sum32() uint32 { return d.crc }
+
+func (d *pinnedDigest) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint32(b, d.crc)
+}
+
+func (d *pinnedDigest) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, pinnedLen)
+	b = append(b, pinnedMagic...)
+	b = append(b, pinnedVersion)
+	b = binary.BigEndian.AppendUint32(b, d.poly.poly)
+	b = binary.BigEndian.AppendUint32(b, d.crc)
+	return b, nil
+}
+
+func (d *pinnedDigest) UnmarshalBinary(b []byte) error {
+	if len(b) < len(pinnedMagic)+1 || string(b[:len(pinnedMagic)]) != pinnedMagic {
+		return fmt.Errorf("%w: unknown identifier", ErrInvalidState)
+	}
+	if v := b[len(pinnedMagic)]; v != pinnedVersion {
+		return fmt.Errorf("%w: unknown version %d", ErrInvalidState, v)
+	}
+	if len(b) != pinnedLen {
+		return fmt.Errorf("%w: got %d bytes; want %d", ErrInvalidState, len(b), pinnedLen)
+	}
+	b = b[len(pinnedMagic)+1:]
+	if binary.BigEndian.Uint32(b) != d.poly.poly {
+		return ErrStateMismatch
+	}
+	d.crc = binary.BigEndian.Uint32(b[Size:])
+	return nil
+}
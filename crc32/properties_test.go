@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import "testing"
+
+func TestProperties(t *testing.T) {
+	tests := []struct {
+		name string
+		poly *Poly
+		want PolyProperties
+	}{
+		{"irreducible", IEEE(), PolyProperties{}},
+		{"x+1 factor", Castagnoli(), PolyProperties{Reducible: true, DivisibleByXPlus1: true}},
+		{"x^32+1", MakePoly(1 << (nBits - 1)), PolyProperties{Reducible: true, DivisibleByXPlus1: true}},
+		{"x factor", MakePoly(3), PolyProperties{Reducible: true}},
+	}
+	for _, tt := range tests {
+		if got := tt.poly.Properties(); got != tt.want {
+			t.Errorf("%s: Poly = 0x%08x; Properties() = %+v; want %+v", tt.name, tt.poly.poly, got, tt.want)
+		}
+		if got := tt.poly.IsReducible(); got != tt.want.Reducible {
+			t.Errorf("%s: Poly = 0x%08x; IsReducible() = %v; want %v", tt.name, tt.poly.poly, got, tt.want.Reducible)
+		}
+	}
+}
+
+func TestHammingDistance(t *testing.T) {
+	// Koopman, "32-Bit Cyclic Redundancy Codes for Internet Applications" (2002).
+	tests := []struct {
+		maxLen int
+		want   int
+	}{
+		{0, 0},
+		{268, 6},
+		{269, 5},
+		{2975, 4},
+	}
+	for _, tt := range tests {
+		if tt.maxLen > 1000 && testing.Short() {
+			continue
+		}
+		if got := IEEE().HammingDistance(tt.maxLen); got != tt.want {
+			t.Errorf("IEEE().HammingDistance(%d) = %d; want %d", tt.maxLen, got, tt.want)
+		}
+	}
+	// x^32 + 1 doesn't detect two errors 32 bits apart.
+	if got := MakePoly(1 << 31).HammingDistance(1); got != 2 {
+		t.Errorf("MakePoly(0x80000000).HammingDistance(1) = %d; want 2", got)
+	}
+}
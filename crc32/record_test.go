@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+	"testing/iotest"
+)
+
+func TestEachRecordCRC(t *testing.T) {
+	const size = 100
+	for _, n := range []int{0, size, 5 * size, 5*size + 17} {
+		data := randData(n)
+		for _, p := range polys {
+			var got []uint32
+			err := p.EachRecordCRC(iotest.HalfReader(bytes.NewReader(data)), size, func(i int64, sum uint32) bool {
+				if i != int64(len(got)) {
+					t.Errorf("Poly = 0x%08x; EachRecordCRC() index = %d; want %d", p.poly, i, len(got))
+				}
+				got = append(got, sum)
+				return true
+			})
+			if err != nil {
+				t.Fatalf("Poly = 0x%08x; EachRecordCRC() failed: %v", p.poly, err)
+			}
+			var want []uint32
+			for i := 0; i < len(data); i += size {
+				want = append(want, p.Checksum(data[i:min(i+size, len(data))]))
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("Poly = 0x%08x; len = %d; EachRecordCRC() = %x; want %x", p.poly, n, got, want)
+			}
+		}
+	}
+}
+
+func TestEachRecordCRCStop(t *testing.T) {
+	p := polys[0]
+	calls := 0
+	err := p.EachRecordCRC(bytes.NewReader(randData(1000)), 10, func(int64, uint32) bool {
+		calls++
+		return calls < 3
+	})
+	if err != nil || calls != 3 {
+		t.Errorf("EachRecordCRC() = %v after %d calls; want nil after 3 calls", err, calls)
+	}
+}
+
+func TestEachRecordCRCError(t *testing.T) {
+	p := polys[0]
+	err := p.EachRecordCRC(iotest.TimeoutReader(bytes.NewReader(randData(1000))), 10, func(int64, uint32) bool { return true })
+	if !errors.Is(err, iotest.ErrTimeout) {
+		t.Errorf("EachRecordCRC() error = %v; want %v", err, iotest.ErrTimeout)
+	}
+	if err := p.EachRecordCRC(bytes.NewReader(nil), 0, nil); err == nil {
+		t.Error("EachRecordCRC() with zero record size succeeded; want error")
+	}
+}
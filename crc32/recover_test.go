@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import "testing"
+
+func TestRecoverPoly(t *testing.T) {
+	data := randData(200)
+	for _, want := range []*Poly{IEEE(), polys[1]} {
+		var pairs []struct {
+			Data []byte
+			Sum  uint32
+		}
+		for i, n := range []int{7, 20, 33, 48, 5, 16} {
+			msg := data[i*30 : i*30+n]
+			pairs = append(pairs, struct {
+				Data []byte
+				Sum  uint32
+			}{msg, ^want.Update(^uint32(0), msg)})
+		}
+		if p, ok := RecoverPoly(pairs); !ok || p.poly != want.poly {
+			t.Errorf("RecoverPoly(pairs of 0x%08x) = (%v, %v); want (0x%08x, true)", want.poly, p, ok, want.poly)
+		}
+		if p, ok := RecoverPoly(pairs[:1]); ok {
+			t.Errorf("RecoverPoly(one pair of 0x%08x) = (0x%08x, true); want false", want.poly, p.poly)
+		}
+		pairs[2].Sum ^= 1
+		if p, ok := RecoverPoly(pairs); ok {
+			t.Errorf("RecoverPoly(inconsistent pairs of 0x%08x) = (0x%08x, true); want false", want.poly, p.poly)
+		}
+	}
+}
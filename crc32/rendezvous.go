@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+// Rendezvous returns the index of the node with the highest checksum of the node
+// followed by the key, as in rendezvous or highest random weight (HRW) hashing.
+// Ties are broken by the lowest index. It returns -1 if there are no nodes.
+//
+// Removing a node only reassigns the keys that were assigned to it.
+func (p *Poly) Rendezvous(key []byte, nodes [][]byte) int {
+	idx := -1
+	var max uint32
+	for i, node := range nodes {
+		if sum := p.Update(p.Checksum(node), key); idx < 0 || sum > max {
+			idx, max = i, sum
+		}
+	}
+	return idx
+}
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+// ChecksumSCTP returns the CRC-32 checksum of packet as if the [Size] bytes of its
+// checksum field at crcOffset were zero, without modifying packet. SCTP (RFC 9260)
+// and iSCSI (RFC 7143) compute their checksums this way using the [Castagnoli] polynomial.
+// It panics if the checksum field isn't within packet.
+func (p *Poly) ChecksumSCTP(packet []byte, crcOffset int) uint32 {
+	return p.checksumZeroed(packet, crcOffset)
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestChecksumSCTP(t *testing.T) {
+	p := Castagnoli()
+	packet := randData(64)
+	orig := bytes.Clone(packet)
+	for off := 0; off+Size <= len(packet); off++ {
+		zeroed := bytes.Clone(packet)
+		clear(zeroed[off : off+Size])
+		if got, want := p.ChecksumSCTP(packet, off), p.Checksum(zeroed); got != want {
+			t.Errorf("ChecksumSCTP(packet, %d) = 0x%08x; want 0x%08x", off, got, want)
+		}
+	}
+	if !bytes.Equal(packet, orig) {
+		t.Errorf("ChecksumSCTP() modified packet")
+	}
+}
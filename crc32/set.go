@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// CombineSet returns an order-independent checksum of the set of sums.
+//
+// The sums are sorted and the checksum is computed over the 8-byte big-endian count
+// of sums followed by their fixed-width big-endian encodings, so any permutation
+// of the same sums yields the same result. The count ensures that leading zero
+// sums, which wouldn't otherwise change the checksum, are not ignored.
+// Unlike folding the sums together with XOR, duplicate sums are not cancelled out
+// and the number of sums contributes to the result. The result is a fingerprint
+// of the set and is not the checksum of the concatenated data of its items.
+// The sums are not modified, but they are copied to be sorted.
+func (p *Poly) CombineSet(sums []uint32) uint32 {
+	sorted := slices.Clone(sums)
+	slices.Sort(sorted)
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(len(sorted)))
+	sum := p.Checksum(buf[:])
+	for _, v := range sorted {
+		binary.BigEndian.PutUint32(buf[:], v)
+		sum = p.Update(sum, buf[:Size])
+	}
+	return sum
+}
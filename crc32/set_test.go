@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestCombineSet(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, p := range polys {
+		var sums []uint32
+		for i := range 16 {
+			sums = append(sums, p.Checksum([]byte{byte(i), byte(r.Intn(256))}))
+		}
+		orig := slices.Clone(sums)
+		want := p.CombineSet(sums)
+		if !slices.Equal(sums, orig) {
+			t.Fatalf("Poly = 0x%08x; CombineSet() modified its input", p.poly)
+		}
+		for range 8 {
+			r.Shuffle(len(sums), func(i, j int) { sums[i], sums[j] = sums[j], sums[i] })
+			if got := p.CombineSet(sums); got != want {
+				t.Errorf("Poly = 0x%08x; CombineSet(permuted) = 0x%08x; want 0x%08x", p.poly, got, want)
+			}
+		}
+		for name, other := range map[string][]uint32{
+			"subset":    sums[1:],
+			"duplicate": append(slices.Clone(sums), sums[0]),
+			"changed":   append([]uint32{sums[0] + 1}, sums[1:]...),
+		} {
+			if got := p.CombineSet(other); got == want {
+				t.Errorf("Poly = 0x%08x; CombineSet({%s}) = 0x%08x; want different result", p.poly, name, got)
+			}
+		}
+		if a, b := p.CombineSet([]uint32{0}), p.CombineSet([]uint32{0, 0}); a == b {
+			t.Errorf("Poly = 0x%08x; CombineSet({0}) and CombineSet({0, 0}) are both 0x%08x", p.poly, a)
+		}
+	}
+}
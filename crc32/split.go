@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import "io"
+
+// SplitWriter returns a writer that duplicates its writes to all of the dsts,
+// like [io.MultiWriter], and a function that returns the CRC-32 checksum of
+// the bytes written to all of them.
+//
+// If a destination returns an error, the write stops there and the error is returned.
+// The checksum and returned count only reflect the bytes fully written to every
+// destination, so bytes written to some but not all destinations are excluded.
+func (p *Poly) SplitWriter(dsts ...io.Writer) (io.Writer, func() uint32) {
+	w := &splitWriter{poly: p, dsts: dsts}
+	return w, func() uint32 { return w.sum }
+}
+
+type splitWriter struct {
+	poly *Poly
+	dsts []io.Writer
+	sum  uint32
+}
+
+func (w *splitWriter) Write(b []byte) (int, error) {
+	for i, dst := range w.dsts {
+		n, err := dst.Write(b)
+		if err == nil && n < len(b) {
+			err = io.ErrShortWrite
+		}
+		if err != nil {
+			if i < len(w.dsts)-1 {
+				n = 0 // The remaining destinations have none of the bytes.
+			}
+			w.sum = w.poly.Update(w.sum, b[:n])
+			return n, err
+		}
+	}
+	w.sum = w.poly.Update(w.sum, b)
+	return len(b), nil
+}
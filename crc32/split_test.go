@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+var errLimit = errors.New("limit reached")
+
+// limitWriter writes at most n bytes to w and then fails.
+type limitWriter struct {
+	w io.Writer
+	n int
+}
+
+func (lw *limitWriter) Write(b []byte) (int, error) {
+	if len(b) <= lw.n {
+		lw.n -= len(b)
+		return lw.w.Write(b)
+	}
+	n, _ := lw.w.Write(b[:lw.n])
+	lw.n -= n
+	return n, errLimit
+}
+
+func TestSplitWriter(t *testing.T) {
+	data := randData(3*bufSize + 7)
+	for _, p := range polys {
+		var a, b bytes.Buffer
+		w, sum := p.SplitWriter(&a, &b)
+		if n, err := io.Copy(w, bytes.NewReader(data)); err != nil || n != int64(len(data)) {
+			t.Fatalf("Poly = 0x%08x; io.Copy() = (%d, %v); want (%d, nil)", p.poly, n, err, len(data))
+		}
+		if !bytes.Equal(a.Bytes(), data) || !bytes.Equal(b.Bytes(), data) {
+			t.Errorf("Poly = 0x%08x; SplitWriter() destinations don't match written data", p.poly)
+		}
+		if got, want := sum(), p.Checksum(data); got != want {
+			t.Errorf("Poly = 0x%08x; SplitWriter() sum = 0x%08x; want 0x%08x", p.poly, got, want)
+		}
+
+		for _, tt := range []struct {
+			name string
+			dsts func(lw io.Writer) []io.Writer
+			want int
+		}{
+			{"first", func(lw io.Writer) []io.Writer { return []io.Writer{lw, io.Discard} }, 10},
+			{"last", func(lw io.Writer) []io.Writer { return []io.Writer{io.Discard, lw} }, 15},
+		} {
+			lw := &limitWriter{w: io.Discard, n: 15}
+			w, sum := p.SplitWriter(tt.dsts(lw)...)
+			w.Write(data[:10])
+			n, err := w.Write(data[10:20])
+			if err != errLimit {
+				t.Errorf("Poly = 0x%08x; %s: Write() error = %v; want %v", p.poly, tt.name, err, errLimit)
+			}
+			if got := 10 + n; got != tt.want {
+				t.Errorf("Poly = 0x%08x; %s: wrote %d bytes to all destinations; want %d", p.poly, tt.name, got, tt.want)
+			}
+			if got, want := sum(), p.Checksum(data[:tt.want]); got != want {
+				t.Errorf("Poly = 0x%08x; %s: SplitWriter() sum = 0x%08x; want 0x%08x", p.poly, tt.name, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"io"
+	"time"
+)
+
+// ChecksumStats describes the work done by [Poly.ChecksumReaderStats].
+type ChecksumStats struct {
+	Bytes    int64         // number of bytes read
+	Duration time.Duration // wall time spent reading and hashing
+}
+
+// Throughput returns the number of bytes read per second,
+// or zero if no time elapsed.
+func (s ChecksumStats) Throughput() float64 {
+	if s.Duration <= 0 {
+		return 0
+	}
+	return float64(s.Bytes) / s.Duration.Seconds()
+}
+
+// ChecksumReaderStats reads r until EOF and returns the CRC-32 checksum along with
+// the number of bytes read and the time spent. If reading fails, it returns the error
+// along with the checksum and stats of the bytes read before it.
+func (p *Poly) ChecksumReaderStats(r io.Reader) (sum uint32, stats ChecksumStats, err error) {
+	start := time.Now()
+	sum, stats.Bytes, err = p.ChecksumReader(r)
+	stats.Duration = time.Since(start)
+	return sum, stats, err
+}
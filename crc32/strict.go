@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidState is returned when unmarshaling hash state
+	// that is malformed or truncated.
+	ErrInvalidState = errors.New("crc32: invalid hash state")
+
+	// ErrStateMismatch is returned when unmarshaling hash state
+	// that was marshaled by a [Hash] using a different polynomial.
+	ErrStateMismatch = errors.New("crc32: hash state polynomial mismatch")
+)
+
+// The marshaled state is laid out as a magic identifier,
+// a checksum of the polynomial's table, and the current sum.
+const stateMagicLen = 4
+
+type strictDigest struct {
+	digest
+}
+
+// NewStrict creates a new [Hash] computing the CRC-32 checksum using the polynomial
+// represented by the [Poly]. Unlike [New], its UnmarshalBinary method returns errors
+// that wrap [ErrInvalidState] or [ErrStateMismatch] to describe why state was rejected.
+func NewStrict(p *Poly) Hash {
+	return &strictDigest{newDigest(p)}
+}
+
+func (d *strictDigest) UnmarshalBinary(b []byte) error {
+	cur, err := d.Hash.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	if len(b) < stateMagicLen || !bytes.Equal(b[:stateMagicLen], cur[:stateMagicLen]) {
+		return fmt.Errorf("%w: unknown identifier", ErrInvalidState)
+	}
+	if len(b) != len(cur) {
+		return fmt.Errorf("%w: got %d bytes; want %d", ErrInvalidState, len(b), len(cur))
+	}
+	if tbl := len(cur) - Size; !bytes.Equal(b[stateMagicLen:tbl], cur[stateMagicLen:tbl]) {
+		return ErrStateMismatch
+	}
+	return d.Hash.UnmarshalBinary(b)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewStrict(t *testing.T) {
+	src := NewStrict(IEEE())
+	src.Write([]byte("hello, world"))
+	state, err := src.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	dst := NewStrict(IEEE())
+	if err := dst.UnmarshalBinary(state); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+	if got, want := dst.Sum32(), src.Sum32(); got != want {
+		t.Errorf("Sum32() = 0x%08x; want 0x%08x", got, want)
+	}
+
+	tests := []struct {
+		name  string
+		hash  Hash
+		state []byte
+		want  error
+	}{
+		{"empty", NewStrict(IEEE()), nil, ErrInvalidState},
+		{"identifier", NewStrict(IEEE()), append([]byte("bad!"), state[stateMagicLen:]...), ErrInvalidState},
+		{"truncated", NewStrict(IEEE()), state[:len(state)-1], ErrInvalidState},
+		{"extended", NewStrict(IEEE()), append(state[:len(state):len(state)], 0), ErrInvalidState},
+		{"mismatch", NewStrict(Castagnoli()), state, ErrStateMismatch},
+	}
+	for _, tt := range tests {
+		if err := tt.hash.UnmarshalBinary(tt.state); !errors.Is(err, tt.want) {
+			t.Errorf("%s: UnmarshalBinary() = %v; want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSaveLoadPolyTable(t *testing.T) {
+	dir := t.TempDir()
+	for i, p := range polys {
+		path := filepath.Join(dir, fmt.Sprint(i))
+		if err := p.SaveTable(path); err != nil {
+			t.Fatalf("Poly = 0x%08x; SaveTable() failed: %v", p.poly, err)
+		}
+		q, err := LoadPolyTable(path)
+		if err != nil {
+			t.Fatalf("Poly = 0x%08x; LoadPolyTable() failed: %v", p.poly, err)
+		}
+		if q.poly != p.poly || q.x2nTbl != p.x2nTbl || *q.stdlib != *p.stdlib {
+			t.Errorf("Poly = 0x%08x; LoadPolyTable() = 0x%08x; tables differ", p.poly, q.poly)
+		}
+		data := randData(1000)
+		if got, want := q.Combine(q.Checksum(data[:10]), q.Checksum(data[10:]), 990), p.Checksum(data); got != want {
+			t.Errorf("Poly = 0x%08x; loaded Combine(...) = 0x%08x; want 0x%08x", p.poly, got, want)
+		}
+	}
+
+	path := filepath.Join(dir, "0")
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"truncated", b[:len(b)-1]},
+		{"magic", append([]byte("bad!"), b[4:]...)},
+		{"corrupt", func() []byte { c := append([]byte(nil), b...); c[100] ^= 1; return c }()},
+	}
+	for _, tt := range tests {
+		bad := filepath.Join(dir, tt.name)
+		if err := os.WriteFile(bad, tt.data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadPolyTable(bad); !errors.Is(err, ErrInvalidTable) {
+			t.Errorf("%s: LoadPolyTable() error = %v; want %v", tt.name, err, ErrInvalidTable)
+		}
+	}
+	if _, err := LoadPolyTable(filepath.Join(dir, "missing")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("LoadPolyTable(missing) error = %v; want %v", err, os.ErrNotExist)
+	}
+}
+
+func TestLoadPolyTableNamed(t *testing.T) {
+	p := polys[0]
+	path := filepath.Join(t.TempDir(), "table")
+	if err := p.SaveTable(path); err != nil {
+		t.Fatal(err)
+	}
+	if q, err := LoadPolyTable(path); err != nil || q != p {
+		t.Errorf("LoadPolyTable() = (%p, %v); want (%p, nil)", q, err, p)
+	}
+}
+
+func TestLoadPolyTableNotCached(t *testing.T) {
+	// A file whose tables are wrong, but which passes the other checks,
+	// mustn't affect later calls to MakePoly.
+	const poly = 0x8badf00d
+	path := filepath.Join(t.TempDir(), "table")
+	if err := makePoly(poly).SaveTable(path); err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[len(tableMagic)+Size*(1+nBits+1)] ^= 1 // stdlib[1]
+	body := b[:len(b)-Size]
+	binary.BigEndian.PutUint32(b[len(body):], Castagnoli().Checksum(body))
+	if err := os.WriteFile(path, b, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadPolyTable(path)
+	if err != nil {
+		t.Fatalf("LoadPolyTable() failed: %v", err)
+	}
+	if p := MakePoly(poly); p == loaded || p.stdlib[1] != makePoly(poly).stdlib[1] {
+		t.Errorf("MakePoly() returned the loaded poly's tables")
+	}
+}
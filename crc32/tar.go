@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ChecksumTar reads a tar archive from r and returns the CRC-32 checksum of the content
+// of each regular file, keyed by name. Other members, such as directories and links,
+// are skipped. If reading fails, it returns an error describing the member being read
+// along with the checksums of the members read before it.
+func ChecksumTar(p *Poly, r io.Reader) (map[string]uint32, error) {
+	sums := make(map[string]uint32)
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return sums, nil
+		}
+		if err != nil {
+			return sums, fmt.Errorf("crc32: reading tar header after %d files: %w", len(sums), err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		sum, _, err := p.ChecksumReader(tr)
+		if err != nil {
+			return sums, fmt.Errorf("crc32: reading tar member %q: %w", hdr.Name, err)
+		}
+		sums[hdr.Name] = sum
+	}
+}
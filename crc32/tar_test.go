@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"maps"
+	"testing"
+)
+
+func TestChecksumTar(t *testing.T) {
+	files := map[string][]byte{
+		"a.txt":       []byte("hello, world"),
+		"dir/b.bin":   randData(3*bufSize + 1),
+		"dir/c/empty": nil,
+	}
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	write := func(hdr *tar.Header, data []byte) {
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(&tar.Header{Name: "dir/", Typeflag: tar.TypeDir, Mode: 0o755}, nil)
+	for _, name := range []string{"a.txt", "dir/b.bin", "dir/c/empty"} {
+		write(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(files[name]))}, files[name])
+	}
+	write(&tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "a.txt"}, nil)
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	archive := buf.Bytes()
+
+	for _, p := range polys {
+		want := make(map[string]uint32)
+		for name, data := range files {
+			want[name] = p.Checksum(data)
+		}
+		got, err := ChecksumTar(p, bytes.NewReader(archive))
+		if err != nil {
+			t.Fatalf("Poly = 0x%08x; ChecksumTar() failed: %v", p.poly, err)
+		}
+		if !maps.Equal(got, want) {
+			t.Errorf("Poly = 0x%08x; ChecksumTar() = %v; want %v", p.poly, got, want)
+		}
+
+		// Truncate within the content of dir/b.bin.
+		got, err = ChecksumTar(p, bytes.NewReader(archive[:4*512]))
+		if !errors.Is(err, io.ErrUnexpectedEOF) || len(got) != 1 {
+			t.Errorf("Poly = 0x%08x; ChecksumTar(truncated) = (%v, %v); want 1 file and %v", p.poly, got, err, io.ErrUnexpectedEOF)
+		}
+	}
+}
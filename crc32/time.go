@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// ChecksumTime returns the CRC-32 checksum of the instant t represents.
+//
+// The time is normalized to the number of seconds since the Unix epoch in UTC
+// as an 8-byte big-endian integer followed by the nanoseconds within that second
+// as a 4-byte big-endian integer. The location and monotonic clock reading are
+// ignored, so times that are equal according to [time.Time.Equal] have the same checksum.
+func (p *Poly) ChecksumTime(t time.Time) uint32 {
+	var buf [12]byte
+	binary.BigEndian.PutUint64(buf[:8], uint64(t.Unix()))
+	binary.BigEndian.PutUint32(buf[8:], uint32(t.Nanosecond()))
+	return p.Checksum(buf[:])
+}
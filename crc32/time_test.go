@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChecksumTime(t *testing.T) {
+	now := time.Now() // Has a monotonic clock reading.
+	zone := time.FixedZone("UTC+5:30", 5*60*60+30*60)
+	for _, p := range polys {
+		want := p.ChecksumTime(now)
+		for _, same := range []time.Time{now.UTC(), now.In(zone), now.Round(0)} {
+			if got := p.ChecksumTime(same); got != want {
+				t.Errorf("Poly = 0x%08x; ChecksumTime(%v) = 0x%08x; want 0x%08x", p.poly, same, got, want)
+			}
+		}
+	}
+	for _, p := range []*Poly{IEEE(), Castagnoli(), Koopman()} {
+		want := p.ChecksumTime(now)
+		for _, other := range []time.Time{now.Add(time.Nanosecond), now.Add(time.Second), now.AddDate(-500, 0, 0)} {
+			if got := p.ChecksumTime(other); got == want {
+				t.Errorf("Poly = 0x%08x; ChecksumTime(%v) = ChecksumTime(%v) = 0x%08x", p.poly, other, now, got)
+			}
+		}
+	}
+}
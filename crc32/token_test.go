@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+)
+
+func TestResume(t *testing.T) {
+	data := randData(1000)
+	for _, p := range polys {
+		h := NewResumable(p)
+		h.Write(data[:400])
+		token := h.Token()
+		if esc := url.QueryEscape(token); esc != token {
+			t.Errorf("Poly = 0x%08x; Token() = %q; want URL-safe", p.poly, token)
+		}
+
+		r, err := p.Resume(token)
+		if err != nil {
+			t.Fatalf("Poly = 0x%08x; Resume() failed: %v", p.poly, err)
+		}
+		r.Write(data[400:])
+		if got, want := r.Sum32(), p.Checksum(data); got != want {
+			t.Errorf("Poly = 0x%08x; Resume().Sum32() = 0x%08x; want 0x%08x", p.poly, got, want)
+		}
+		if got, want := r.Len(), int64(len(data)); got != want {
+			t.Errorf("Poly = 0x%08x; Resume().Len() = %d; want %d", p.poly, got, want)
+		}
+	}
+
+	token := NewResumable(polys[0]).Token()
+	tests := []struct {
+		name  string
+		poly  *Poly
+		token string
+		want  error
+	}{
+		{"mismatch", polys[1], token, ErrStateMismatch},
+		{"empty", polys[0], "", ErrInvalidState},
+		{"base64", polys[0], token + "!", ErrInvalidState},
+		{"truncated", polys[0], token[:len(token)-2], ErrInvalidState},
+		{"extended", polys[0], token + "AA", ErrInvalidState},
+	}
+	for _, tt := range tests {
+		if _, err := tt.poly.Resume(tt.token); !errors.Is(err, tt.want) {
+			t.Errorf("%s: Resume(%q) error = %v; want %v", tt.name, tt.token, err, tt.want)
+		}
+	}
+}
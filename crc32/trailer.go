@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// VerifyStreamTrailer reads payloadLen bytes of payload from br followed by a trailer
+// containing its big-endian CRC-32 checksum and reports whether the checksum matches.
+// The payload is hashed in place within br's buffer without being copied.
+// If fewer than payloadLen+[Size] bytes are available, it returns [io.ErrUnexpectedEOF].
+func (p *Poly) VerifyStreamTrailer(br *bufio.Reader, payloadLen int64) (bool, error) {
+	if payloadLen < 0 {
+		return false, errors.New("crc32: negative payload length")
+	}
+	var sum uint32
+	for n := payloadLen; n > 0; {
+		b, err := br.Peek(int(min(n, int64(br.Size()))))
+		sum = p.Update(sum, b)
+		br.Discard(len(b))
+		n -= int64(len(b))
+		if err != nil {
+			return false, noEOF(err)
+		}
+	}
+	b, err := br.Peek(Size)
+	if err != nil {
+		return false, noEOF(err)
+	}
+	want := binary.BigEndian.Uint32(b)
+	br.Discard(Size)
+	return sum == want, nil
+}
+
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+)
+
+func TestVerifyStreamTrailer(t *testing.T) {
+	payload := bytes.Repeat([]byte("0123456789"), 100) // Larger than the bufio.Reader's buffer.
+	next := []byte("next")
+	for _, p := range polys {
+		frame := binary.BigEndian.AppendUint32(bytes.Clone(payload), p.Checksum(payload))
+		corrupt := bytes.Clone(frame)
+		corrupt[len(payload)+1] ^= 1
+
+		tests := []struct {
+			name string
+			data []byte
+			n    int64
+			ok   bool
+			err  error
+		}{
+			{"valid", frame, int64(len(payload)), true, nil},
+			{"empty", binary.BigEndian.AppendUint32(nil, p.Checksum(nil)), 0, true, nil},
+			{"corrupt", corrupt, int64(len(payload)), false, nil},
+			{"short payload", payload[:10], int64(len(payload)), false, io.ErrUnexpectedEOF},
+			{"short trailer", frame[:len(frame)-1], int64(len(payload)), false, io.ErrUnexpectedEOF},
+		}
+		for _, tt := range tests {
+			data := tt.data
+			if tt.err == nil {
+				data = append(bytes.Clone(data), next...)
+			}
+			br := bufio.NewReaderSize(bytes.NewReader(data), 16)
+			ok, err := p.VerifyStreamTrailer(br, tt.n)
+			if ok != tt.ok || err != tt.err {
+				t.Errorf("Poly = 0x%08x; %s: VerifyStreamTrailer() = (%v, %v); want (%v, %v)", p.poly, tt.name, ok, err, tt.ok, tt.err)
+				continue
+			}
+			if err == nil {
+				if rest, _ := io.ReadAll(br); !bytes.Equal(rest, next) {
+					t.Errorf("Poly = 0x%08x; %s: VerifyStreamTrailer() left %q unread; want %q", p.poly, tt.name, rest, next)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc32
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// CheckAgainst reports whether the CRC-32 checksum of data matches want
+// and returns the computed checksum, so it can be logged on mismatch.
+func (p *Poly) CheckAgainst(data []byte, want uint32) (ok bool, got uint32) {
+	got = p.Checksum(data)
+	return got == want, got
+}
+
+// Verify reports whether the CRC-32 checksum of data matches expected.
+func (p *Poly) Verify(data []byte, expected uint32) bool {
+	return p.Checksum(data) == expected
+}
+
+// A Verifier is an [io.Writer] that checks the bytes written to it against an expected
+// CRC-32 checksum without buffering them, such as while copying a large payload with
+// [io.Copy]. It must be created by [Poly.NewVerifier].
+type Verifier struct {
+	poly *Poly
+	want uint32
+	sum  uint32
+}
+
+// NewVerifier returns a new [Verifier] checking against the expected CRC-32 checksum
+// using the polynomial represented by the [Poly].
+func (p *Poly) NewVerifier(expected uint32) *Verifier {
+	return &Verifier{poly: p, want: expected}
+}
+
+// Write adds the bytes in b to the running checksum. It never returns an error.
+func (v *Verifier) Write(b []byte) (int, error) {
+	v.sum = v.poly.Update(v.sum, b)
+	return len(b), nil
+}
+
+// Valid reports whether the checksum of the bytes written so far matches the expected
+// checksum. It should be called after all of the bytes have been written.
+func (v *Verifier) Valid() bool {
+	return v.sum == v.want
+}
+
+// Sum32 returns the checksum of the bytes written so far, so it can be logged on mismatch.
+func (v *Verifier) Sum32() uint32 {
+	return v.sum
+}
+
+// LooksAlreadyTerminated reports whether data ends with the CRC-32 checksum of the
+// bytes preceding it, in either big-endian or little-endian byte order. It's a heuristic
+// for detecting data to which a checksum has already been appended. Any data has
+// a small chance of ending with such bytes by accident, so false positives are possible.
+func (p *Poly) LooksAlreadyTerminated(data []byte) bool {
+	if len(data) < Size {
+		return false
+	}
+	n := len(data) - Size
+	sum := p.Checksum(data[:n])
+	return binary.BigEndian.Uint32(data[n:]) == sum || binary.LittleEndian.Uint32(data[n:]) == sum
+}
+
+// VerifyChain reports whether combining the segment sums, each with the corresponding
+// segment length, in order yields the expected total. If runningTotals isn't nil, it holds
+// the total recorded after each segment, and badIndex is the index of the first segment
+// after which the combined sum diverges from it. Otherwise, or if the running totals all
+// match but the expected total doesn't, badIndex is -1, as the divergent segment can't be
+// identified. If the segments are missing lengths or running totals, badIndex is the index
+// of the first such segment.
+func (p *Poly) VerifyChain(segmentSums []uint32, segmentLens []int64, runningTotals []uint32, expectedTotal uint32) (badIndex int, ok bool) {
+	n := min(len(segmentSums), len(segmentLens))
+	if runningTotals != nil {
+		n = min(n, len(runningTotals))
+	}
+	if n != len(segmentSums) || n != len(segmentLens) || (runningTotals != nil && n != len(runningTotals)) {
+		return n, false
+	}
+	var sum uint32
+	for i, s := range segmentSums {
+		sum = p.Combine(sum, s, segmentLens[i])
+		if runningTotals != nil && sum != runningTotals[i] {
+			return i, false
+		}
+	}
+	return -1, sum == expectedTotal
+}
+
+// VerifyEmbedded reports whether the CRC-32 checksum stored in the given byte order
+// in the [Size] bytes of packet at crcOffset matches the checksum of packet computed as
+// if those bytes were zero. It doesn't modify packet. It returns an error if the field
+// isn't within packet.
+func (p *Poly) VerifyEmbedded(packet []byte, crcOffset int, order binary.ByteOrder) (bool, error) {
+	if crcOffset < 0 || crcOffset > len(packet)-Size {
+		return false, errors.New("crc32: checksum offset out of range")
+	}
+	want := order.Uint32(packet[crcOffset:])
+	return p.checksumZeroed(packet, crcOffset) == want, nil
+}
+
+// checksumZeroed returns the checksum of packet as if the [Size] bytes at off were zero.
+func (p *Poly) checksumZeroed(packet []byte, off int) uint32 {
+	sum := p.ExtendZeros(p.Checksum(packet[:off]), Size)
+	return p.Update(sum, packet[off+Size:])
+}
+
+// VerifyResult is the outcome of verifying a CRC-32 checksum, for structured logging
+// and telemetry.
+type VerifyResult struct {
+	OK   bool   // whether the checksum matched
+	Got  uint32 // computed checksum
+	Want uint32 // expected checksum
+	Len  int    // number of bytes verified
+}
+
+// Error returns a description of the mismatch, or an empty string if the checksum matched.
+func (r VerifyResult) Error() string {
+	if r.OK {
+		return ""
+	}
+	return fmt.Sprintf("crc32: checksum mismatch: got 0x%08x, want 0x%08x over %d bytes", r.Got, r.Want, r.Len)
+}
+
+// VerifyDetailed verifies that the CRC-32 checksum of data matches want
+// and returns the outcome.
+func (p *Poly) VerifyDetailed(data []byte, want uint32) VerifyResult {
+	got := p.Checksum(data)
+	return VerifyResult{OK: got == want, Got: got, Want: want, Len: len(data)}
+}
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+// HardwareAccelerated reports whether the standard library computes checksums for the
+// polynomial using the CPU's specialized instructions instead of tables. It's always
+// false, because the standard library doesn't accelerate CRC-64 on any platform, but
+// it's provided for parity with the crc32 package in case that changes.
+func (p *Poly) HardwareAccelerated() bool {
+	return false
+}
@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import "testing"
+
+func TestHardwareAccelerated(t *testing.T) {
+	for _, p := range polys {
+		if p.HardwareAccelerated() {
+			t.Errorf("%v.HardwareAccelerated() = true; want false", p)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import "testing"
+
+func TestAccumulator(t *testing.T) {
+	data := randData(1000)
+	for _, p := range polys {
+		a := p.NewAccumulator()
+		for i := 0; i < len(data); i += i + 1 {
+			end := min(2*i+1, len(data))
+			a.Append(data[i:end])
+			if got, want := a.Sum64(), p.Checksum(data[:end]); got != want {
+				t.Errorf("Poly = 0x%016x; Accumulator.Sum64() after %d bytes = 0x%016x; want 0x%016x", p.poly, end, got, want)
+			}
+			if got, want := a.Len(), int64(end); got != want {
+				t.Errorf("Poly = 0x%016x; Accumulator.Len() = %d; want %d", p.poly, got, want)
+			}
+		}
+	}
+}
+
+func TestAccumulatorRollback(t *testing.T) {
+	data := randData(100)
+	for _, p := range polys {
+		a := p.NewAccumulator()
+		a.Append(data[:10])
+		m10 := a.Mark()
+		a.Append(data[10:50])
+		m50 := a.Mark()
+		a.Append(data[50:])
+
+		a.Rollback(m50)
+		if got, want := a.Sum64(), p.Checksum(data[:50]); got != want || a.Len() != 50 {
+			t.Errorf("Poly = 0x%016x; Rollback(%d) = (0x%016x, %d); want (0x%016x, 50)", p.poly, m50, got, a.Len(), want)
+		}
+		a.Append([]byte("other"))
+		a.Rollback(m10)
+		if got, want := a.Sum64(), p.Checksum(data[:10]); got != want || a.Len() != 10 {
+			t.Errorf("Poly = 0x%016x; Rollback(%d) = (0x%016x, %d); want (0x%016x, 10)", p.poly, m10, got, a.Len(), want)
+		}
+		a.Append(data[10:])
+		if got, want := a.Sum64(), p.Checksum(data); got != want {
+			t.Errorf("Poly = 0x%016x; Accumulator.Sum64() after Rollback and Append = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Poly = 0x%016x; Rollback(%d) of discarded mark didn't panic", p.poly, m50)
+				}
+			}()
+			a.Rollback(m50)
+		}()
+	}
+}
+
+func TestAccumulatorRelease(t *testing.T) {
+	data := randData(1000)
+	for _, p := range polys {
+		a := p.NewAccumulator()
+		var prev int64
+		for i := range 100 {
+			a.Append(data[10*i : 10*(i+1)])
+			mark := a.Mark()
+			if i > 0 {
+				a.Release(prev)
+			}
+			prev = mark
+		}
+		if len(a.marks) != 1 {
+			t.Errorf("Poly = 0x%016x; Accumulator has %d marks after releasing all but one; want 1", p.poly, len(a.marks))
+		}
+
+		a = p.NewAccumulator()
+		a.Append(data[:10])
+		m10 := a.Mark()
+		a.Append(data[10:50])
+		m50 := a.Mark()
+		a.Append(data[50:])
+		a.Release(m10)
+		a.Rollback(m50)
+		if got, want := a.Sum64(), p.Checksum(data[:50]); got != want || a.Len() != 50 {
+			t.Errorf("Poly = 0x%016x; Rollback(%d) after Release(%d) = (0x%016x, %d); want (0x%016x, 50)", p.poly, m50, m10, got, a.Len(), want)
+		}
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Poly = 0x%016x; Rollback(%d) of released mark didn't panic", p.poly, m10)
+				}
+			}()
+			a.Rollback(m10)
+		}()
+	}
+}
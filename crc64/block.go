@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import "sync"
+
+// BlockChecksums returns the CRC-64 checksum of each consecutive blockSize-byte block
+// of data, followed by that of the final partial block, if any. It returns nil if
+// blockSize isn't positive.
+func (p *Poly) BlockChecksums(data []byte, blockSize int) []uint64 {
+	if blockSize <= 0 {
+		return nil
+	}
+	sums := make([]uint64, 0, (len(data)+blockSize-1)/blockSize)
+	for off := 0; off < len(data); off += blockSize {
+		sums = append(sums, p.Checksum(data[off:min(off+blockSize, len(data))]))
+	}
+	return sums
+}
+
+// BlockChecksumsParallel is like [Poly.BlockChecksums], but hashes the blocks concurrently
+// using the given number of worker goroutines, each of which hashes a consecutive run of blocks.
+// Small inputs are hashed with fewer workers, or serially, to avoid needless goroutines.
+func (p *Poly) BlockChecksumsParallel(data []byte, blockSize, workers int) []uint64 {
+	if blockSize <= 0 {
+		return nil
+	}
+	n := (len(data) + blockSize - 1) / blockSize
+	workers = min(workers, n, len(data)/minShardSize)
+	if workers <= 1 {
+		return p.BlockChecksums(data, blockSize)
+	}
+	sums := make([]uint64, n)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := range workers {
+		go func() {
+			defer wg.Done()
+			for i := w * n / workers; i < (w+1)*n/workers; i++ {
+				sums[i] = p.Checksum(data[i*blockSize : min((i+1)*blockSize, len(data))])
+			}
+		}()
+	}
+	wg.Wait()
+	return sums
+}
+
+// A Match is a block of the source found in the target.
+type Match struct {
+	Offset int64 // offset of the block in the target
+	Block  int   // index of the block in the source
+}
+
+// RollingMatch finds the blocks of a source in target, given the checksums of the source's
+// blocks as returned by [Poly.BlockChecksums], keyed by checksum with their indexes as values.
+// Like rsync, it slides a window of blockSize bytes over target one byte at a time, updating
+// its checksum in constant time, and skips past each block it matches. A final partial block
+// of the source can only be matched at the end of target. Matches are returned in order of
+// their offsets. It returns nil if blockSize isn't positive.
+func (p *Poly) RollingMatch(target []byte, blockSums map[uint64]int, blockSize int) []Match {
+	if blockSize <= 0 {
+		return nil
+	}
+	var matches []Match
+	var r *roller
+	off := 0
+	for off+blockSize <= len(target) {
+		sum := p.Checksum(target[off : off+blockSize])
+		for {
+			if i, ok := blockSums[sum]; ok {
+				matches = append(matches, Match{Offset: int64(off), Block: i})
+				off += blockSize
+				break
+			}
+			if off+blockSize == len(target) {
+				off++ // the tail may still end with a partial block
+				break
+			}
+			if r == nil {
+				r = p.newRoller(blockSize)
+			}
+			sum = r.roll(sum, target[off], target[off+blockSize:off+blockSize+1])
+			off++
+		}
+	}
+	if off < len(target) {
+		if m, ok := p.matchTail(target, off, blockSums); ok {
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
+
+// matchTail finds the longest suffix of target[off:] in blockSums. Rather than hashing
+// each suffix anew, it derives the sum of each from the sum of the whole tail and that
+// of the bytes before the suffix.
+func (p *Poly) matchTail(target []byte, off int, blockSums map[uint64]int) (Match, bool) {
+	whole := p.Checksum(target[off:])
+	var prefix uint64
+	for i := off; i < len(target); i++ {
+		suffix := whole ^ p.Combine(prefix, 0, int64(len(target)-i))
+		if j, ok := blockSums[suffix]; ok {
+			return Match{Offset: int64(i), Block: j}, true
+		}
+		prefix = p.Update(prefix, target[i:i+1])
+	}
+	return Match{}, false
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// catalog holds models from the CRC RevEng catalogue by name.
+var catalog = map[string]Model{
+	"CRC-64/ECMA-182": {Width: nBits, Poly: 0x42f0e1eba9ea3693, Init: 0x0000000000000000, RefIn: false, RefOut: false, XorOut: 0x0000000000000000},
+	"CRC-64/GO-ISO":   {Width: nBits, Poly: 0x000000000000001b, Init: 0xffffffffffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffffffffffff},
+	"CRC-64/MS":       {Width: nBits, Poly: 0x259c84cba6426349, Init: 0xffffffffffffffff, RefIn: true, RefOut: true, XorOut: 0x0000000000000000},
+	"CRC-64/REDIS":    {Width: nBits, Poly: 0xad93d23594c935a9, Init: 0x0000000000000000, RefIn: true, RefOut: true, XorOut: 0x0000000000000000},
+	"CRC-64/WE":       {Width: nBits, Poly: 0x42f0e1eba9ea3693, Init: 0xffffffffffffffff, RefIn: false, RefOut: false, XorOut: 0xffffffffffffffff},
+	"CRC-64/XZ":       {Width: nBits, Poly: 0x42f0e1eba9ea3693, Init: 0xffffffffffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffffffffffff},
+}
+
+// checks holds the check values of the models in the catalog by name,
+// which are the checksums of "123456789" published in the CRC RevEng catalogue.
+var checks = map[string]uint64{
+	"CRC-64/ECMA-182": 0x6c40df5f0b497347,
+	"CRC-64/GO-ISO":   0xb90956c775a41001,
+	"CRC-64/MS":       0x75d4b74f024eceea,
+	"CRC-64/REDIS":    0xe9c6d914c4b8d9ca,
+	"CRC-64/WE":       0x62ec59e3f1a4f00a,
+	"CRC-64/XZ":       0x995dc9bbdf1939fa,
+}
+
+// aliases maps alternative names to names in the catalog.
+var aliases = map[string]string{
+	"CRC-64":         "CRC-64/ECMA-182",
+	"CRC-64/GO-ECMA": "CRC-64/XZ",
+}
+
+// Lookup returns the [Model] of the named CRC-64 algorithm from the CRC RevEng
+// catalogue, such as "CRC-64/XZ", and reports whether it was found.
+// Names are case-insensitive, and well-known aliases are also accepted.
+func Lookup(name string) (Model, bool) {
+	name = strings.ToUpper(name)
+	if canon, ok := aliases[name]; ok {
+		name = canon
+	}
+	m, ok := catalog[name]
+	return m, ok
+}
+
+// Names returns the sorted names of the algorithms known by [Lookup], excluding aliases.
+func Names() []string {
+	return slices.Sorted(maps.Keys(catalog))
+}
+
+// A TestVector is an input and its checksum as documented by a model's specification.
+type TestVector struct {
+	Input string // input to the model
+	Want  uint64 // documented checksum of the input
+}
+
+// TestVectors returns the test vectors documented for the model by the CRC RevEng
+// catalogue, if it's one of the models known by [Lookup], or nil otherwise. Running
+// them validates the implementation of the model.
+func (m Model) TestVectors() []TestVector {
+	for name, c := range catalog {
+		if c == m {
+			return []TestVector{{Input: "123456789", Want: checks[name]}}
+		}
+	}
+	return nil
+}
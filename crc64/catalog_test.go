@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	// Check values are published in the CRC RevEng catalogue.
+	published := map[string]uint64{
+		"CRC-64/ECMA-182": 0x6c40df5f0b497347,
+		"CRC-64/GO-ISO":   0xb90956c775a41001,
+		"CRC-64/MS":       0x75d4b74f024eceea,
+		"CRC-64/REDIS":    0xe9c6d914c4b8d9ca,
+		"CRC-64/WE":       0x62ec59e3f1a4f00a,
+		"CRC-64/XZ":       0x995dc9bbdf1939fa,
+	}
+	names := Names()
+	if !slices.IsSorted(names) || len(names) != len(published) {
+		t.Fatalf("Names() = %q; want %d sorted names", names, len(published))
+	}
+	for _, name := range names {
+		m, ok := Lookup(name)
+		if !ok {
+			t.Errorf("Lookup(%q) not found", name)
+			continue
+		}
+		if got, want := m.Check(), published[name]; got != want {
+			t.Errorf("Lookup(%q).Check() = 0x%016x; want 0x%016x", name, got, want)
+		}
+		if got, want := m.TestVectors(), []TestVector{{"123456789", published[name]}}; !slices.Equal(got, want) {
+			t.Errorf("Lookup(%q).TestVectors() = %+v; want %+v", name, got, want)
+		}
+		if lower, ok := Lookup(strings.ToLower(name)); !ok || lower != m {
+			t.Errorf("Lookup(%q) = %+v, %v; want %+v, true", strings.ToLower(name), lower, ok, m)
+		}
+	}
+	for alias, name := range aliases {
+		if got, ok := Lookup(alias); !ok || got != catalog[name] {
+			t.Errorf("Lookup(%q) = %+v, %v; want %s", alias, got, ok, name)
+		}
+	}
+	if _, ok := Lookup("CRC-64/UNKNOWN"); ok {
+		t.Errorf("Lookup(%q) found an unknown algorithm", "CRC-64/UNKNOWN")
+	}
+}
+
+func TestTestVectors(t *testing.T) {
+	for _, name := range Names() {
+		m, _ := Lookup(name)
+		for _, v := range m.TestVectors() {
+			if got := m.Checksum([]byte(v.Input)); got != v.Want {
+				t.Errorf("Lookup(%q).Checksum(%q) = 0x%016x; want 0x%016x", name, v.Input, got, v.Want)
+			}
+		}
+	}
+	m, _ := Lookup(Names()[0])
+	m.Init ^= 1
+	if got := m.TestVectors(); got != nil {
+		t.Errorf("Model = %+v; TestVectors() = %+v; want nil", m, got)
+	}
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+// A CircularCRC tracks the CRC-64 checksum of the most recent bytes appended to a
+// fixed-capacity circular log, which overwrites its oldest bytes when it's full.
+// It must be created by [Poly.NewCircularCRC].
+type CircularCRC struct {
+	poly  *Poly
+	buf   []byte
+	start int // index of the oldest byte in buf
+	n     int // number of bytes in buf
+	sum   uint64
+}
+
+// NewCircularCRC returns a new [CircularCRC] holding at most capacity bytes and
+// computing the CRC-64 checksum using the polynomial represented by the [Poly].
+// It panics if capacity isn't positive.
+func (p *Poly) NewCircularCRC(capacity int) *CircularCRC {
+	if capacity <= 0 {
+		panic("crc64: non-positive circular capacity")
+	}
+	return &CircularCRC{poly: p, buf: make([]byte, capacity)}
+}
+
+// Append adds the bytes in data to the log, first removing as many of the oldest bytes
+// as needed to make room for them. The checksum is updated by removing the evicted bytes
+// from its front, without rehashing the bytes that remain.
+func (c *CircularCRC) Append(data []byte) {
+	size := len(c.buf)
+	if len(data) >= size {
+		data = data[len(data)-size:]
+		copy(c.buf, data)
+		c.start, c.n, c.sum = 0, size, c.poly.Checksum(data)
+		return
+	}
+	if k := c.n + len(data) - size; k > 0 {
+		var evicted uint64
+		if end := c.start + k; end <= size {
+			evicted = c.poly.Checksum(c.buf[c.start:end])
+		} else {
+			evicted = c.poly.Update(c.poly.Checksum(c.buf[c.start:]), c.buf[:end-size])
+		}
+		c.n -= k
+		c.sum ^= c.poly.Combine(evicted, 0, int64(c.n))
+		c.start = (c.start + k) % size
+	}
+	c.sum = c.poly.Update(c.sum, data)
+	end := (c.start + c.n) % size
+	m := copy(c.buf[end:], data)
+	copy(c.buf, data[m:])
+	c.n += len(data)
+}
+
+// Sum64 returns the checksum of the bytes in the log.
+func (c *CircularCRC) Sum64() uint64 {
+	return c.sum
+}
+
+// Len returns the number of bytes in the log.
+func (c *CircularCRC) Len() int {
+	return c.n
+}
+
+// Bytes appends the bytes in the log, from oldest to newest, to b and returns the result.
+func (c *CircularCRC) Bytes(b []byte) []byte {
+	if end := c.start + c.n; end <= len(c.buf) {
+		return append(b, c.buf[c.start:end]...)
+	}
+	b = append(b, c.buf[c.start:]...)
+	return append(b, c.buf[:c.start+c.n-len(c.buf)]...)
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestCircularCRC(t *testing.T) {
+	const capacity = 1000
+	data := randData(20 * capacity)
+	for _, p := range polys {
+		rng := rand.New(rand.NewSource(1))
+		c := p.NewCircularCRC(capacity)
+		for off := 0; off < len(data); {
+			n := min(rng.Intn(capacity+100), len(data)-off)
+			c.Append(data[off : off+n])
+			off += n
+
+			window := data[max(0, off-capacity):off]
+			if got := c.Bytes(nil); !bytes.Equal(got, window) {
+				t.Fatalf("Poly = 0x%016x; after %d bytes, Bytes() has %d bytes; want the last %d", p.poly, off, len(got), len(window))
+			}
+			if got, want := c.Sum64(), p.Checksum(window); got != want || c.Len() != len(window) {
+				t.Fatalf("Poly = 0x%016x; after %d bytes, (Sum64(), Len()) = (0x%016x, %d); want (0x%016x, %d)", p.poly, off, got, c.Len(), want, len(window))
+			}
+		}
+	}
+}
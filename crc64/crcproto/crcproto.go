@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+// Package crcproto computes CRC-64 checksums of protocol buffer messages.
+// It's separate from package crc64 so that only its users depend on protobuf.
+package crcproto
+
+import (
+	crc "bursavich.dev/crc/crc64"
+	"google.golang.org/protobuf/proto"
+)
+
+var deterministic = proto.MarshalOptions{Deterministic: true}
+
+// Checksum returns the CRC-64 checksum of m's deterministic wire encoding using
+// the polynomial represented by p. Map entries are ordered by key, so logically equal
+// messages have equal checksums within the same binary. Deterministic encoding isn't
+// canonical, so the checksum may change between versions of the protobuf library
+// or of m's schema.
+func Checksum(p *crc.Poly, m proto.Message) (uint64, error) {
+	b, err := deterministic.Marshal(m)
+	if err != nil {
+		return 0, err
+	}
+	return p.Checksum(b), nil
+}
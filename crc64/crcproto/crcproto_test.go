@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crcproto
+
+import (
+	"fmt"
+	"testing"
+
+	crc "bursavich.dev/crc/crc64"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestChecksum(t *testing.T) {
+	fields := make(map[string]any)
+	for i := range 20 {
+		fields[fmt.Sprint("key", i)] = i
+	}
+	a, err := structpb.NewStruct(fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &structpb.Struct{Fields: make(map[string]*structpb.Value)}
+	for i := 19; i >= 0; i-- {
+		b.Fields[fmt.Sprint("key", i)] = structpb.NewNumberValue(float64(i))
+	}
+	c := proto.Clone(b).(*structpb.Struct)
+	c.Fields["key0"] = structpb.NewNumberValue(-1)
+
+	for _, p := range []*crc.Poly{crc.ISO(), crc.ECMA()} {
+		aSum, err := Checksum(p, a)
+		if err != nil {
+			t.Fatalf("Poly = 0x%016x; Checksum() failed: %v", p.Polynomial(), err)
+		}
+		for range 10 {
+			if bSum, err := Checksum(p, b); err != nil || bSum != aSum {
+				t.Errorf("Poly = 0x%016x; Checksum(equal) = (0x%016x, %v); want (0x%016x, nil)", p.Polynomial(), bSum, err, aSum)
+			}
+		}
+		if cSum, err := Checksum(p, c); err != nil || cSum == aSum {
+			t.Errorf("Poly = 0x%016x; Checksum(different) = (0x%016x, %v); want not 0x%016x", p.Polynomial(), cSum, err, aSum)
+		}
+	}
+}
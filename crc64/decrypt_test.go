@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"testing"
+	"testing/iotest"
+)
+
+func TestChecksumDecrypting(t *testing.T) {
+	key, iv := make([]byte, 16), make([]byte, aes.BlockSize)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain := randData(3*bufSize + 17)
+	cipherText := make([]byte, len(plain))
+	cipher.NewCTR(block, iv).XORKeyStream(cipherText, plain)
+
+	for _, p := range polys {
+		sum, n, err := p.ChecksumDecrypting(iotest.HalfReader(bytes.NewReader(cipherText)), cipher.NewCTR(block, iv))
+		if err != nil {
+			t.Fatalf("Poly = 0x%016x; ChecksumDecrypting() failed: %v", p.poly, err)
+		}
+		if want := p.Checksum(plain); sum != want || n != int64(len(plain)) {
+			t.Errorf("Poly = 0x%016x; ChecksumDecrypting() = (0x%016x, %d); want (0x%016x, %d)", p.poly, sum, n, want, len(plain))
+		}
+		// The pool is likely, but not guaranteed, to return the buffer just used.
+		buf := bufPool.Get().(*[]byte)
+		if !bytes.Equal(*buf, make([]byte, len(*buf))) {
+			t.Errorf("Poly = 0x%016x; ChecksumDecrypting() left plaintext in a pooled buffer", p.poly)
+		}
+		bufPool.Put(buf)
+
+		errTest := errors.New("test")
+		r := iotest.TimeoutReader(bytes.NewReader(cipherText))
+		if _, _, err := p.ChecksumDecrypting(iotest.ErrReader(errTest), cipher.NewCTR(block, iv)); err != errTest {
+			t.Errorf("Poly = 0x%016x; ChecksumDecrypting(ErrReader) error = %v; want %v", p.poly, err, errTest)
+		}
+		if _, n, err := p.ChecksumDecrypting(r, cipher.NewCTR(block, iv)); err != iotest.ErrTimeout || n == 0 {
+			t.Errorf("Poly = 0x%016x; ChecksumDecrypting(TimeoutReader) = (%d, %v); want (>0, %v)", p.poly, n, err, iotest.ErrTimeout)
+		}
+	}
+}
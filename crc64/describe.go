@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"fmt"
+	"hash/crc64"
+	"math/bits"
+	"strings"
+)
+
+// standardNames identify the named polynomials and their CRC RevEng catalogue entries.
+var standardNames = map[uint64]string{
+	crc64.ISO:  "ISO (CRC-64/GO-ISO)",
+	crc64.ECMA: "ECMA (CRC-64/XZ)",
+}
+
+// Describe returns a multi-line, human-readable description of the CRC's configuration:
+// its width, polynomial in normal and reversed forms, initial and final XOR values,
+// check value, residue, and the standard it matches, if any. The format is meant for
+// people and may change.
+func (p *Poly) Describe() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "width:    %d\n", nBits)
+	fmt.Fprintf(&b, "poly:     0x%016x (normal), 0x%016x (reversed)\n", bits.Reverse64(p.poly), p.poly)
+	fmt.Fprintf(&b, "init:     0x%016x\n", ^uint64(0))
+	fmt.Fprintf(&b, "xorout:   0x%016x\n", ^uint64(0))
+	fmt.Fprintf(&b, "check:    0x%016x\n", p.ChecksumString("123456789"))
+	fmt.Fprintf(&b, "residue:  0x%016x (little-endian appended checksum)\n", p.Residue())
+	if name, ok := standardNames[p.poly]; ok {
+		fmt.Fprintf(&b, "standard: %s\n", name)
+	} else {
+		b.WriteString("standard: none\n")
+	}
+	return b.String()
+}
+
+// String returns the polynomial in reversed representation, followed by its name
+// if it's a named polynomial, such as "crc64.Poly(0xc96c5795d7870f42, ECMA)".
+func (p *Poly) String() string {
+	if p == nil {
+		return "crc64.Poly(nil)"
+	}
+	if name, ok := standardNames[p.poly]; ok {
+		name, _, _ = strings.Cut(name, " ")
+		return fmt.Sprintf("crc64.Poly(0x%016x, %s)", p.poly, name)
+	}
+	return fmt.Sprintf("crc64.Poly(0x%016x)", p.poly)
+}
+
+// Equal reports whether p and q represent the same polynomial.
+// Two nil polys are equal, but a nil poly isn't equal to any other.
+func (p *Poly) Equal(q *Poly) bool {
+	if p == nil || q == nil {
+		return p == q
+	}
+	return p.poly == q.poly
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	got := ECMA().Describe()
+	for _, want := range []string{
+		"width:    64\n",
+		"poly:     0x42f0e1eba9ea3693 (normal), 0xc96c5795d7870f42 (reversed)\n",
+		"check:    0x995dc9bbdf1939fa\n",
+		"standard: ECMA (CRC-64/XZ)\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("ECMA().Describe() = %q; want it to contain %q", got, want)
+		}
+	}
+
+	for _, p := range polys {
+		// The residue is the checksum of any data followed by its little-endian checksum.
+		data := []byte("hello, world")
+		data = binary.LittleEndian.AppendUint64(data, p.Checksum(data))
+		want := fmt.Sprintf("residue:  0x%016x ", p.Checksum(data))
+		if got := p.Describe(); !strings.Contains(got, want) {
+			t.Errorf("Poly = 0x%016x; Describe() = %q; want it to contain %q", p.poly, got, want)
+		}
+	}
+	if got := MakePoly(1).Describe(); !strings.HasSuffix(got, "standard: none\n") {
+		t.Errorf("MakePoly(1).Describe() = %q; want no standard", got)
+	}
+}
+
+func TestPolyString(t *testing.T) {
+	tests := []struct {
+		poly *Poly
+		want string
+	}{
+		{ECMA(), "crc64.Poly(0xc96c5795d7870f42, ECMA)"},
+		{MakePoly(1), "crc64.Poly(0x0000000000000001)"},
+		{nil, "crc64.Poly(nil)"},
+	}
+	for _, tt := range tests {
+		if got := tt.poly.String(); got != tt.want {
+			t.Errorf("String() = %q; want %q", got, tt.want)
+		}
+	}
+}
+
+func TestPolyEqual(t *testing.T) {
+	var null *Poly
+	tests := []struct {
+		p, q *Poly
+		want bool
+	}{
+		{ECMA(), ECMA(), true},
+		{ECMA(), makePoly(0xc96c5795d7870f42), true},
+		{ECMA(), MakePoly(1), false},
+		{ECMA(), nil, false},
+		{null, ECMA(), false},
+		{null, nil, true},
+	}
+	for _, tt := range tests {
+		if got := tt.p.Equal(tt.q); got != tt.want {
+			t.Errorf("%v.Equal(%v) = %v; want %v", tt.p, tt.q, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+// RegionCRC describes the CRC-64 checksum of a region of data.
+type RegionCRC struct {
+	Start int64  // offset of the region
+	Len   int64  // length of the region
+	Sum   uint64 // checksum of the region
+}
+
+// RegionCRCs returns the regions of a forming the longest prefix common to a and b,
+// the differing middle, and the longest suffix common to a and b that doesn't overlap
+// the prefix, along with their checksums. The regions are located within a; the middle
+// of b is b[prefix.Len:len(b)-suffix.Len]. If a and b are equal, the middle is empty.
+func (p *Poly) RegionCRCs(a, b []byte) (prefix, middle, suffix RegionCRC) {
+	n := min(len(a), len(b))
+	i := 0
+	for i < n && a[i] == b[i] {
+		i++
+	}
+	j := 0
+	for j < n-i && a[len(a)-1-j] == b[len(b)-1-j] {
+		j++
+	}
+	region := func(start, end int) RegionCRC {
+		return RegionCRC{
+			Start: int64(start),
+			Len:   int64(end - start),
+			Sum:   p.Checksum(a[start:end]),
+		}
+	}
+	return region(0, i), region(i, len(a)-j), region(len(a)-j, len(a))
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRegionCRCs(t *testing.T) {
+	data := randData(1000)
+	changed := slices.Concat(data[:400], []byte("inserted"), data[450:])
+	tests := []struct {
+		name       string
+		a, b       []byte
+		start, end int // middle of a
+	}{
+		{"equal", data, data, 1000, 1000},
+		{"middle", data, changed, 400, 450},
+		{"reversed", changed, data, 400, 408},
+		{"prefix", data[:600], data, 600, 600},
+		{"suffix", data[100:], data, 0, 0},
+		{"empty", nil, data, 0, 0},
+	}
+	for _, p := range polys {
+		for _, tt := range tests {
+			prefix, middle, suffix := p.RegionCRCs(tt.a, tt.b)
+			want := []RegionCRC{
+				{0, int64(tt.start), p.Checksum(tt.a[:tt.start])},
+				{int64(tt.start), int64(tt.end - tt.start), p.Checksum(tt.a[tt.start:tt.end])},
+				{int64(tt.end), int64(len(tt.a) - tt.end), p.Checksum(tt.a[tt.end:])},
+			}
+			if got := []RegionCRC{prefix, middle, suffix}; !slices.Equal(got, want) {
+				t.Errorf("Poly = 0x%016x; %s: RegionCRCs() = %+v; want %+v", p.poly, tt.name, got, want)
+			}
+		}
+	}
+}
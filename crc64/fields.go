@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import "encoding/binary"
+
+// ChecksumFields returns the CRC-64 checksum of a tuple of fields, each prefixed
+// by its length as an 8-byte big-endian integer. The length prefixes keep the
+// boundaries between fields unambiguous, so ("ab", "c") and ("a", "bc") have
+// different checksums even though their concatenations are identical.
+func (p *Poly) ChecksumFields(fields ...[]byte) uint64 {
+	return p.hashParts(fields...)
+}
+
+// ChecksumLengthTagged returns the CRC-64 checksum of data followed by its length
+// as an 8-byte big-endian integer, without copying data. Inputs of different lengths
+// are tagged differently, even if one extends the other without changing its checksum.
+func (p *Poly) ChecksumLengthTagged(data []byte) uint64 {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(len(data)))
+	return p.Update(p.Checksum(data), buf[:])
+}
+
+// hashParts returns the checksum of the parts, each prefixed by its length as an
+// 8-byte big-endian integer. Helpers that hash multiple inputs share this encoding
+// so that the boundaries between their inputs are unambiguous.
+func (p *Poly) hashParts(parts ...[]byte) uint64 {
+	var sum uint64
+	for _, b := range parts {
+		sum = p.updateLenPrefixed(sum, b)
+	}
+	return sum
+}
+
+// updateLenPrefixed returns the result of adding the 8-byte big-endian length of b
+// followed by the bytes of b to the sum.
+func (p *Poly) updateLenPrefixed(sum uint64, b []byte) uint64 {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(len(b)))
+	return p.Update(p.Update(sum, buf[:]), b)
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestChecksumFields(t *testing.T) {
+	groupings := [][][]byte{
+		nil,
+		{nil},
+		{nil, nil},
+		{[]byte("abc")},
+		{[]byte("ab"), []byte("c")},
+		{[]byte("a"), []byte("bc")},
+		{[]byte("a"), []byte("b"), []byte("c")},
+		{[]byte("abc"), nil},
+		{nil, []byte("abc")},
+	}
+	for _, p := range polys {
+		seen := make(map[uint64][][]byte)
+		for _, fields := range groupings {
+			sum := p.ChecksumFields(fields...)
+			if prev, ok := seen[sum]; ok {
+				t.Errorf("Poly = 0x%016x; ChecksumFields(%q) = ChecksumFields(%q) = 0x%016x", p.poly, fields, prev, sum)
+			}
+			seen[sum] = fields
+		}
+
+		var buf []byte
+		for _, f := range [][]byte{[]byte("ab"), []byte("c")} {
+			buf = binary.BigEndian.AppendUint64(buf, uint64(len(f)))
+			buf = append(buf, f...)
+		}
+		if got, want := p.ChecksumFields([]byte("ab"), []byte("c")), p.Checksum(buf); got != want {
+			t.Errorf("Poly = 0x%016x; ChecksumFields(\"ab\", \"c\") = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+	}
+}
+
+func TestChecksumLengthTagged(t *testing.T) {
+	for _, p := range polys {
+		for _, data := range [][]byte{nil, []byte("123456789"), randData(1000)} {
+			want := p.Checksum(binary.BigEndian.AppendUint64(data[:len(data):len(data)], uint64(len(data))))
+			if got := p.ChecksumLengthTagged(data); got != want {
+				t.Errorf("Poly = 0x%016x; ChecksumLengthTagged(%d bytes) = 0x%016x; want 0x%016x", p.poly, len(data), got, want)
+			}
+		}
+
+		// Appending a little-endian checksum yields the same residue every time,
+		// so these have equal plain checksums though one is a prefix of the other.
+		a := []byte("123456789")
+		a = binary.LittleEndian.AppendUint64(a, p.Checksum(a))
+		b := binary.LittleEndian.AppendUint64(a[:len(a):len(a)], p.Checksum(a))
+		if p.Checksum(a) != p.Checksum(b) {
+			t.Fatalf("Poly = 0x%016x; Checksum(%x) = 0x%016x; want 0x%016x", p.poly, b, p.Checksum(b), p.Checksum(a))
+		}
+		if x, y := p.ChecksumLengthTagged(a), p.ChecksumLengthTagged(b); x == y {
+			t.Errorf("Poly = 0x%016x; ChecksumLengthTagged(%x) = ChecksumLengthTagged(%x) = 0x%016x", p.poly, a, b, x)
+		}
+	}
+}
+
+func TestHashParts(t *testing.T) {
+	helpers := []struct {
+		name  string
+		sum   func(p *Poly, parts ...string) uint64
+		cases [][]string
+	}{
+		{
+			name: "ChecksumFields",
+			sum: func(p *Poly, parts ...string) uint64 {
+				var fields [][]byte
+				for _, s := range parts {
+					fields = append(fields, []byte(s))
+				}
+				return p.ChecksumFields(fields...)
+			},
+			cases: [][]string{{"abc"}, {"ab", "c"}, {"a", "bc"}, {"a", "b", "c"}, {"abc", ""}},
+		},
+		{
+			name: "ChecksumStringMap",
+			sum: func(p *Poly, parts ...string) uint64 {
+				m := make(map[string]string)
+				for i := 0; i < len(parts); i += 2 {
+					m[parts[i]] = parts[i+1]
+				}
+				return p.ChecksumStringMap(m)
+			},
+			cases: [][]string{{"ab", "c"}, {"a", "bc"}, {"a", "b", "c", ""}, {"", "abc"}},
+		},
+	}
+	for _, p := range polys {
+		for _, h := range helpers {
+			seen := make(map[uint64][]string)
+			for _, parts := range h.cases {
+				var b [][]byte
+				for _, s := range parts {
+					b = append(b, []byte(s))
+				}
+				sum := h.sum(p, parts...)
+				if want := p.hashParts(b...); sum != want {
+					t.Errorf("Poly = 0x%016x; %s(%q) = 0x%016x; want 0x%016x", p.poly, h.name, parts, sum, want)
+				}
+				if prev, ok := seen[sum]; ok {
+					t.Errorf("Poly = 0x%016x; %s(%q) = %s(%q) = 0x%016x", p.poly, h.name, parts, h.name, prev, sum)
+				}
+				seen[sum] = parts
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// canonicalNaN is the bit pattern of the quiet NaN used in place of every NaN.
+const canonicalNaN = 0x7ff8000000000000
+
+// ChecksumFloat64 returns the CRC-64 checksum of the IEEE 754 binary representations
+// of vs, each laid out in big-endian byte order.
+//
+// The values are canonicalized before they're hashed: negative zero is replaced by
+// positive zero and every NaN is replaced by a single quiet NaN, so values that
+// compare equal, or are both NaN, have the same checksum.
+func (p *Poly) ChecksumFloat64(vs ...float64) uint64 {
+	var (
+		sum uint64
+		buf [8]byte
+	)
+	for _, v := range vs {
+		bits := math.Float64bits(v)
+		switch {
+		case v == 0:
+			bits = 0
+		case v != v:
+			bits = canonicalNaN
+		}
+		binary.BigEndian.PutUint64(buf[:], bits)
+		sum = p.Update(sum, buf[:])
+	}
+	return sum
+}
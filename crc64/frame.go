@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+var (
+	// ErrCRCMismatch is returned when a message's checksum doesn't match its payload.
+	ErrCRCMismatch = errors.New("crc64: checksum mismatch")
+
+	// ErrMessageTooLarge is returned when a message's length exceeds the maximum.
+	ErrMessageTooLarge = errors.New("crc64: message too large")
+)
+
+// DefaultMaxMessageLen is the maximum payload length accepted by [Poly.ReadVerifiedMessage].
+const DefaultMaxMessageLen = 16 << 20
+
+// The size of a message's length prefix in bytes.
+const msgLenSize = 4
+
+// ReadVerifiedMessage reads a message from r consisting of a 4-byte big-endian payload
+// length, the payload, and the payload's big-endian CRC-64 checksum, and returns the
+// payload. It returns [ErrCRCMismatch] if the checksum doesn't match and an error wrapping
+// [ErrMessageTooLarge] if the length exceeds [DefaultMaxMessageLen]. If r is at EOF,
+// it returns [io.EOF]; if a message is truncated, it returns [io.ErrUnexpectedEOF].
+func (p *Poly) ReadVerifiedMessage(r io.Reader) ([]byte, error) {
+	return p.ReadVerifiedMessageLimit(r, DefaultMaxMessageLen)
+}
+
+// ReadVerifiedMessageLimit is like [Poly.ReadVerifiedMessage], but rejects payloads
+// longer than maxLen bytes instead of [DefaultMaxMessageLen]. Payloads that with their
+// checksum would be longer than [math.MaxInt] bytes are always rejected.
+func (p *Poly) ReadVerifiedMessageLimit(r io.Reader, maxLen int) ([]byte, error) {
+	var hdr [msgLenSize]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, err
+	}
+	n := binary.BigEndian.Uint32(hdr[:])
+	// The payload and checksum must fit in an int, which may have only 32 bits.
+	maxLen = min(max(maxLen, 0), math.MaxInt-Size)
+	if uint64(n) > uint64(maxLen) {
+		return nil, fmt.Errorf("%w: %d bytes exceeds %d", ErrMessageTooLarge, n, maxLen)
+	}
+	b := make([]byte, int(n)+Size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, noEOF(err)
+	}
+	payload := b[:n:n]
+	if p.Checksum(payload) != binary.BigEndian.Uint64(b[n:]) {
+		return nil, ErrCRCMismatch
+	}
+	return payload, nil
+}
+
+// WriteFramedMessage writes a message to w consisting of a 4-byte big-endian payload
+// length, the payload, and the payload's big-endian CRC-64 checksum, as read by
+// [Poly.ReadVerifiedMessage]. It returns an error wrapping [ErrMessageTooLarge]
+// if the payload's length doesn't fit in the length prefix.
+//
+// The message is written with a single call to w's Write method, so, on a best-effort
+// basis, a failed write doesn't leave a partial frame unless w writes partially.
+func (p *Poly) WriteFramedMessage(w io.Writer, payload []byte) error {
+	if uint64(len(payload)) > math.MaxUint32 {
+		return fmt.Errorf("%w: %d bytes exceeds %d", ErrMessageTooLarge, len(payload), uint32(math.MaxUint32))
+	}
+	b := make([]byte, 0, msgLenSize+len(payload)+Size)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(payload)))
+	b = append(b, payload...)
+	b = binary.BigEndian.AppendUint64(b, p.Checksum(payload))
+	_, err := w.Write(b)
+	return err
+}
+
+// VerifyFrames reports, for each of the frames, whether it's a single complete message
+// as written by [Poly.WriteFramedMessage] whose checksum matches its payload.
+// Frames are verified in place without copying.
+func (p *Poly) VerifyFrames(frames [][]byte) []bool {
+	ok := make([]bool, len(frames))
+	for i, b := range frames {
+		if len(b) < msgLenSize+Size || uint64(binary.BigEndian.Uint32(b)) != uint64(len(b)-msgLenSize-Size) {
+			continue
+		}
+		payload := b[msgLenSize : len(b)-Size]
+		ok[i] = p.Checksum(payload) == binary.BigEndian.Uint64(b[len(b)-Size:])
+	}
+	return ok
+}
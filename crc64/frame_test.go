@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"slices"
+	"testing"
+)
+
+func appendFrame(b []byte, p *Poly, payload []byte) []byte {
+	b = binary.BigEndian.AppendUint32(b, uint32(len(payload)))
+	b = append(b, payload...)
+	return binary.BigEndian.AppendUint64(b, p.Checksum(payload))
+}
+
+func TestReadVerifiedMessage(t *testing.T) {
+	for _, p := range polys {
+		a, b := randData(100), []byte{}
+		r := bytes.NewReader(appendFrame(appendFrame(nil, p, a), p, b))
+		for _, want := range [][]byte{a, b} {
+			got, err := p.ReadVerifiedMessage(r)
+			if err != nil {
+				t.Fatalf("Poly = 0x%016x; ReadVerifiedMessage() failed: %v", p.poly, err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("Poly = 0x%016x; ReadVerifiedMessage() = %x; want %x", p.poly, got, want)
+			}
+		}
+		if _, err := p.ReadVerifiedMessage(r); err != io.EOF {
+			t.Errorf("Poly = 0x%016x; ReadVerifiedMessage() at end error = %v; want %v", p.poly, err, io.EOF)
+		}
+
+		frame := appendFrame(nil, p, a)
+		corrupt := bytes.Clone(frame)
+		corrupt[msgLenSize+10] ^= 1
+		tests := []struct {
+			name  string
+			frame []byte
+			max   int
+			want  error
+		}{
+			{"corrupt", corrupt, DefaultMaxMessageLen, ErrCRCMismatch},
+			{"truncated", frame[:len(frame)-1], DefaultMaxMessageLen, io.ErrUnexpectedEOF},
+			{"header", frame[:msgLenSize-1], DefaultMaxMessageLen, io.ErrUnexpectedEOF},
+			{"oversized", frame, len(a) - 1, ErrMessageTooLarge},
+			{"huge", binary.BigEndian.AppendUint32(nil, 1<<31), DefaultMaxMessageLen, ErrMessageTooLarge},
+		}
+		if math.MaxInt == math.MaxInt32 {
+			// On 32-bit platforms, the length of the payload and checksum would overflow.
+			tests = append(tests, struct {
+				name  string
+				frame []byte
+				max   int
+				want  error
+			}{"overflow", binary.BigEndian.AppendUint32(nil, math.MaxInt32-1), math.MaxInt, ErrMessageTooLarge})
+		}
+		for _, tt := range tests {
+			if _, err := p.ReadVerifiedMessageLimit(bytes.NewReader(tt.frame), tt.max); !errors.Is(err, tt.want) {
+				t.Errorf("Poly = 0x%016x; %s: ReadVerifiedMessageLimit() error = %v; want %v", p.poly, tt.name, err, tt.want)
+			}
+		}
+	}
+}
+
+func TestWriteFramedMessage(t *testing.T) {
+	for _, p := range polys {
+		var buf bytes.Buffer
+		payloads := [][]byte{randData(100), nil, []byte("hello, world")}
+		for _, payload := range payloads {
+			if err := p.WriteFramedMessage(&buf, payload); err != nil {
+				t.Fatalf("Poly = 0x%016x; WriteFramedMessage() failed: %v", p.poly, err)
+			}
+		}
+		var want []byte
+		for _, payload := range payloads {
+			want = appendFrame(want, p, payload)
+		}
+		if !bytes.Equal(buf.Bytes(), want) {
+			t.Errorf("Poly = 0x%016x; WriteFramedMessage() wrote %x; want %x", p.poly, buf.Bytes(), want)
+		}
+		for _, payload := range payloads {
+			got, err := p.ReadVerifiedMessage(&buf)
+			if err != nil || !bytes.Equal(got, payload) {
+				t.Errorf("Poly = 0x%016x; ReadVerifiedMessage() = (%x, %v); want (%x, nil)", p.poly, got, err, payload)
+			}
+		}
+
+		w := &limitWriter{w: io.Discard, n: 10}
+		if err := p.WriteFramedMessage(w, payloads[0]); err != errLimit {
+			t.Errorf("Poly = 0x%016x; WriteFramedMessage(limitWriter) error = %v; want %v", p.poly, err, errLimit)
+		}
+	}
+}
+
+func TestVerifyFrames(t *testing.T) {
+	for _, p := range polys {
+		valid := appendFrame(nil, p, randData(100))
+		corrupt := bytes.Clone(valid)
+		corrupt[msgLenSize+50] ^= 1
+		frames := [][]byte{
+			valid,
+			appendFrame(nil, p, nil),
+			corrupt,
+			valid[:len(valid)-1],
+			append(bytes.Clone(valid), 0),
+			nil,
+		}
+		want := []bool{true, true, false, false, false, false}
+		if got := p.VerifyFrames(frames); !slices.Equal(got, want) {
+			t.Errorf("Poly = 0x%016x; VerifyFrames() = %v; want %v", p.poly, got, want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+// Hasher64 computes a CRC-64 checksum incrementally. Unlike [Hash], it's a concrete
+// value type, so it may be allocated on the stack and its methods may be inlined.
+// A Hasher64 must be created by [Poly.Hasher].
+type Hasher64 struct {
+	poly *Poly
+	sum  uint64
+}
+
+// Hasher returns a new [Hasher64] computing the CRC-64 checksum using the polynomial
+// represented by the [Poly].
+func (p *Poly) Hasher() Hasher64 {
+	return Hasher64{poly: p}
+}
+
+// Write adds the bytes in data to the running checksum. It never returns an error.
+func (h *Hasher64) Write(data []byte) (int, error) {
+	h.sum = h.poly.Update(h.sum, data)
+	return len(data), nil
+}
+
+// Sum64 returns the running checksum.
+func (h *Hasher64) Sum64() uint64 {
+	return h.sum
+}
+
+// Reset resets the running checksum to its initial state.
+func (h *Hasher64) Reset() {
+	h.sum = 0
+}
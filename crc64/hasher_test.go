@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"io"
+	"testing"
+)
+
+var _ io.Writer = (*Hasher64)(nil)
+
+func TestHasher(t *testing.T) {
+	a, b := []byte("hello, "), []byte("world")
+	for _, p := range polys {
+		want := p.Update(p.Checksum(a), b)
+		h := p.Hasher()
+		h.Write(a)
+		h.Write(b)
+		if got := h.Sum64(); got != want {
+			t.Errorf("Poly = 0x%016x; Hasher.Sum64() = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+		h.Reset()
+		h.Write(b)
+		if got, want := h.Sum64(), p.Checksum(b); got != want {
+			t.Errorf("Poly = 0x%016x; Hasher.Sum64() after Reset = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+	}
+}
+
+var benchMsgs = func() [][]byte {
+	msgs := make([][]byte, 64)
+	for i := range msgs {
+		msgs[i] = make([]byte, 16+i)
+	}
+	return msgs
+}()
+
+var benchSum uint64
+
+func BenchmarkHasher(b *testing.B) {
+	p := ISO()
+	b.ReportAllocs()
+	for range b.N {
+		for _, msg := range benchMsgs {
+			h := p.Hasher()
+			h.Write(msg)
+			benchSum = h.Sum64()
+		}
+	}
+}
+
+func BenchmarkHash(b *testing.B) {
+	p := ISO()
+	b.ReportAllocs()
+	for range b.N {
+		for _, msg := range benchMsgs {
+			h := New(p)
+			h.Write(msg)
+			benchSum = h.Sum64()
+		}
+	}
+}
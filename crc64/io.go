@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"errors"
+	"hash"
+	"io"
+	"sync"
+)
+
+const bufSize = 32 << 10
+
+var bufPool = sync.Pool{
+	New: func() any {
+		buf := make([]byte, bufSize)
+		return &buf
+	},
+}
+
+// ChecksumMmap returns the CRC-64 checksum of the whole region of r,
+// such as a memory-mapped file opened with golang.org/x/exp/mmap.
+// The region is read in chunks through a pooled buffer, so any type with
+// the same methods may be used without depending on the mmap package.
+func (p *Poly) ChecksumMmap(r interface {
+	io.ReaderAt
+	Len() int
+}) (uint64, error) {
+	sum, _, err := p.ChecksumReader(io.NewSectionReader(r, 0, int64(r.Len())))
+	return sum, err
+}
+
+// VerifyWhileReading reads r until EOF and reports whether the CRC-64 checksum
+// of the bytes read matches want, along with the number of bytes read.
+// If reading fails, it returns the error and false.
+func (p *Poly) VerifyWhileReading(r io.Reader, want uint64) (bool, int64, error) {
+	sum, n, err := p.ChecksumReader(r)
+	return err == nil && sum == want, n, err
+}
+
+// ChecksumPipe reads pr until the writer closes it and returns the CRC-64 checksum
+// and number of bytes read. If the writer closes the pipe with an error, it returns
+// that error along with the checksum and length of the bytes read before it.
+// It closes pr before returning, so later writes fail instead of blocking.
+func (p *Poly) ChecksumPipe(pr *io.PipeReader) (uint64, int64, error) {
+	defer pr.Close()
+	return p.ChecksumReader(pr)
+}
+
+// FirstDifferentBlock reads a and b in blocks of blockSize bytes and returns the offset
+// of the first block whose CRC-64 checksums differ, or -1 if the checksums of every
+// block match up to the length of the shorter reader. Blocks are compared lazily and
+// reading stops at the first difference.
+func (p *Poly) FirstDifferentBlock(a, b io.Reader, blockSize int) (int64, error) {
+	if blockSize <= 0 {
+		return 0, errors.New("crc64: non-positive block size")
+	}
+	bufA, bufB := make([]byte, blockSize), make([]byte, blockSize)
+	for off := int64(0); ; off += int64(blockSize) {
+		na, errA := io.ReadFull(a, bufA)
+		nb, errB := io.ReadFull(b, bufB)
+		if err := noEOFs(errA, errB); err != nil {
+			return 0, err
+		}
+		n := min(na, nb)
+		if p.Checksum(bufA[:n]) != p.Checksum(bufB[:n]) {
+			return off, nil
+		}
+		if errA != nil || errB != nil {
+			return -1, nil
+		}
+	}
+}
+
+// ChecksumAlongside reads r until EOF and returns the CRC-64 checksum and number of
+// bytes read, also writing the bytes to each of the extra hashes, whose sums the caller
+// may then read. It reads r only once. If reading fails, it returns the error along with
+// the checksum and length of the bytes read before it.
+func (p *Poly) ChecksumAlongside(r io.Reader, extra ...hash.Hash) (sum uint64, n int64, err error) {
+	buf := bufPool.Get().(*[]byte)
+	defer bufPool.Put(buf)
+	for {
+		m, err := r.Read(*buf)
+		b := (*buf)[:m]
+		sum = p.Update(sum, b)
+		for _, h := range extra {
+			h.Write(b) // hashes never fail
+		}
+		n += int64(m)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			return sum, n, err
+		}
+	}
+}
+
+// noEOFs returns the first error that doesn't indicate the end of a stream.
+func noEOFs(errs ...error) error {
+	for _, err := range errs {
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChecksumReaderBuf reads r until EOF using buf as scratch space and returns the
+// CRC-64 checksum and number of bytes read. Unlike other readers in this package,
+// it doesn't take buffers from a shared pool. If reading fails, it returns the error
+// along with the checksum and length of the bytes read before it.
+func (p *Poly) ChecksumReaderBuf(r io.Reader, buf []byte) (uint64, int64, error) {
+	if len(buf) == 0 {
+		return 0, 0, errors.New("crc64: empty buffer")
+	}
+	return p.checksumReaderBuf(r, buf)
+}
+
+func (p *Poly) ChecksumReader(r io.Reader) (uint64, int64, error) {
+	buf := bufPool.Get().(*[]byte)
+	defer bufPool.Put(buf)
+	return p.checksumReaderBuf(r, *buf)
+}
+
+func (p *Poly) checksumReaderBuf(r io.Reader, buf []byte) (sum uint64, n int64, err error) {
+	for {
+		m, err := r.Read(buf)
+		sum = p.Update(sum, buf[:m])
+		n += int64(m)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			return sum, n, err
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import "math/rand/v2"
+
+// A Seed is a random value that selects the specific hash function computed by a [MapHasher].
+// Like [hash/maphash.Seed], it can't be serialized or otherwise recreated, so hash values
+// are only stable within a single process. The zero Seed is invalid.
+type Seed struct {
+	s uint64
+}
+
+// MakeSeed returns a new random seed.
+func MakeSeed() Seed {
+	for {
+		if s := rand.Uint64(); s != 0 {
+			return Seed{s: s}
+		}
+	}
+}
+
+// MapHasher computes seeded hashes of byte sequences using the ECMA polynomial,
+// with the same contract as [hash/maphash.Hash]: hash values depend on the seed
+// and are only stable within a single process. The zero MapHasher is valid and
+// uses a random seed chosen on first use.
+//
+// A CRC is linear, so colliding inputs collide under every seed and collisions
+// are easy to construct. MapHasher must not be used with adversarial inputs,
+// for which [hash/maphash] should be used instead. Where [hash/maphash] is hardware
+// accelerated, it's also typically faster, particularly for short inputs, so
+// compare them with the package benchmarks before substituting one for the other.
+type MapHasher struct {
+	seed Seed
+	sum  uint64
+}
+
+func (h *MapHasher) init() {
+	if h.seed.s == 0 {
+		h.SetSeed(MakeSeed())
+	}
+}
+
+// Seed returns h's seed, choosing a random one if it hasn't been set.
+func (h *MapHasher) Seed() Seed {
+	h.init()
+	return h.seed
+}
+
+// SetSeed sets h to use seed and resets it. It panics if seed is the zero Seed.
+func (h *MapHasher) SetSeed(seed Seed) {
+	if seed.s == 0 {
+		panic("crc64: MapHasher.SetSeed with zero seed")
+	}
+	h.seed = seed
+	h.sum = seed.s
+}
+
+// Reset discards all bytes added to h, keeping its seed.
+func (h *MapHasher) Reset() {
+	h.init()
+	h.sum = h.seed.s
+}
+
+// Write adds b to the sequence of bytes hashed by h. It never returns an error.
+func (h *MapHasher) Write(b []byte) (int, error) {
+	h.init()
+	h.sum = ECMA().Update(h.sum, b)
+	return len(b), nil
+}
+
+// WriteString adds the bytes of s to the sequence of bytes hashed by h.
+// It never returns an error.
+func (h *MapHasher) WriteString(s string) (int, error) {
+	h.init()
+	h.sum = ECMA().Update(h.sum, []byte(s))
+	return len(s), nil
+}
+
+// WriteByte adds b to the sequence of bytes hashed by h. It never returns an error.
+func (h *MapHasher) WriteByte(b byte) error {
+	h.init()
+	h.sum = ECMA().Update(h.sum, []byte{b})
+	return nil
+}
+
+// Sum64 returns h's current hash value.
+func (h *MapHasher) Sum64() uint64 {
+	h.init()
+	return h.sum
+}
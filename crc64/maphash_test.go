@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"hash/maphash"
+	"testing"
+)
+
+func TestMapHasher(t *testing.T) {
+	var a, b MapHasher
+	a.WriteString("hello, ")
+	a.Write([]byte("wor"))
+	a.WriteByte('l')
+	a.WriteByte('d')
+	b.SetSeed(a.Seed())
+	b.WriteString("hello, world")
+	if x, y := a.Sum64(), b.Sum64(); x != y {
+		t.Errorf("MapHasher.Sum64() = 0x%016x and 0x%016x with the same seed and input; want equal", x, y)
+	}
+
+	var c MapHasher
+	c.SetSeed(MakeSeed())
+	c.WriteString("hello, world")
+	if c.Seed() == a.Seed() || c.Sum64() == a.Sum64() {
+		t.Errorf("MapHasher.Sum64() = 0x%016x with different seeds; want different", c.Sum64())
+	}
+
+	a.Reset()
+	a.WriteString("hello, world")
+	if x, y := a.Sum64(), b.Sum64(); x != y {
+		t.Errorf("MapHasher.Sum64() after Reset = 0x%016x; want 0x%016x", x, y)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("MapHasher.SetSeed(Seed{}) didn't panic")
+			}
+		}()
+		a.SetSeed(Seed{})
+	}()
+}
+
+func BenchmarkMapHasher(b *testing.B) {
+	seed := MakeSeed()
+	b.ReportAllocs()
+	for range b.N {
+		for _, msg := range benchMsgs {
+			var h MapHasher
+			h.SetSeed(seed)
+			h.Write(msg)
+			benchSum = h.Sum64()
+		}
+	}
+}
+
+func BenchmarkMaphash(b *testing.B) {
+	seed := maphash.MakeSeed()
+	b.ReportAllocs()
+	for range b.N {
+		for _, msg := range benchMsgs {
+			var h maphash.Hash
+			h.SetSeed(seed)
+			h.Write(msg)
+			benchSum = h.Sum64()
+		}
+	}
+}
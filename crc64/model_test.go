@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"errors"
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+func TestModelCheck(t *testing.T) {
+	// Check values are published in the CRC RevEng catalogue.
+	tests := []struct {
+		name  string
+		model Model
+		want  uint64
+	}{
+		{"CRC-64/ECMA-182", Model{Width: nBits, Poly: 0x42f0e1eba9ea3693, Init: 0x0000000000000000, RefIn: false, RefOut: false, XorOut: 0x0000000000000000}, 0x6c40df5f0b497347},
+		{"CRC-64/XZ", Model{Width: nBits, Poly: 0x42f0e1eba9ea3693, Init: 0xffffffffffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffffffffffff}, 0x995dc9bbdf1939fa},
+		{"CRC-64/WE", Model{Width: nBits, Poly: 0x42f0e1eba9ea3693, Init: 0xffffffffffffffff, RefIn: false, RefOut: false, XorOut: 0xffffffffffffffff}, 0x62ec59e3f1a4f00a},
+		{"CRC-64/GO-ISO", Model{Width: nBits, Poly: 0x000000000000001b, Init: 0xffffffffffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffffffffffff}, 0xb90956c775a41001},
+	}
+	for _, tt := range tests {
+		if got := tt.model.Check(); got != tt.want {
+			t.Errorf("%s: Check() = 0x%016x; want 0x%016x", tt.name, got, tt.want)
+		}
+	}
+}
+
+// modelBits is a bitwise reference that follows the model's definition directly.
+func modelBits(m Model, data []byte) uint64 {
+	crc := m.Init
+	for _, v := range data {
+		if m.RefIn {
+			v = bits.Reverse8(v)
+		}
+		crc ^= uint64(v) << (nBits - 8)
+		for range 8 {
+			if crc&(uint64(1)<<(nBits-1)) != 0 {
+				crc = crc<<1 ^ m.Poly
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	if m.RefOut {
+		crc = bits.Reverse64(crc)
+	}
+	return crc ^ m.XorOut
+}
+
+func TestModel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	data := randData(300)
+	for range 32 {
+		m := Model{
+			Width:  nBits,
+			Poly:   uint64(r.Uint64()) | 1,
+			Init:   uint64(r.Uint64()),
+			RefIn:  r.Intn(2) == 0,
+			RefOut: r.Intn(2) == 0,
+			XorOut: uint64(r.Uint64()),
+		}
+		want := modelBits(m, data)
+		if got := m.Checksum(data); got != want {
+			t.Errorf("Model = %+v; Checksum() = 0x%016x; want 0x%016x", m, got, want)
+		}
+
+		h := m.New()
+		h.Write(data[:100])
+		state, err := h.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Model = %+v; MarshalBinary() failed: %v", m, err)
+		}
+		resumed := m.New()
+		if err := resumed.UnmarshalBinary(state); err != nil {
+			t.Fatalf("Model = %+v; UnmarshalBinary() failed: %v", m, err)
+		}
+		resumed.Write(data[100:])
+		if got := resumed.Sum64(); got != want {
+			t.Errorf("Model = %+v; resumed Sum64() = 0x%016x; want 0x%016x", m, got, want)
+		}
+
+		other := m
+		other.RefOut = !other.RefOut
+		if err := other.New().UnmarshalBinary(state); !errors.Is(err, ErrStateMismatch) {
+			t.Errorf("Model = %+v; UnmarshalBinary() of another model's state error = %v; want %v", m, err, ErrStateMismatch)
+		}
+		if err := m.New().UnmarshalBinary(state[:len(state)-1]); !errors.Is(err, ErrInvalidState) {
+			t.Errorf("Model = %+v; UnmarshalBinary() of truncated state error = %v; want %v", m, err, ErrInvalidState)
+		}
+	}
+}
+
+func TestModelMakePoly(t *testing.T) {
+	for _, poly := range []uint64{0x42f0e1eba9ea3693, 0x259c84cba6426349} {
+		a := Model{Width: nBits, Poly: poly, RefIn: true, RefOut: true}
+		b := Model{Width: nBits, Poly: poly, Init: ^uint64(0), XorOut: ^uint64(0)}
+		if a.MakePoly() != b.MakePoly() {
+			t.Errorf("Poly = 0x%016x; models don't share a Poly", poly)
+		}
+		if got, want := a.MakePoly().poly, bits.Reverse64(poly); got != want {
+			t.Errorf("Poly = 0x%016x; MakePoly().poly = 0x%016x; want 0x%016x", poly, got, want)
+		}
+		for _, m := range []Model{a, b} {
+			if m.New().(*modelDigest).poly != a.MakePoly() {
+				t.Errorf("Model = %+v; New() doesn't use the shared Poly", m)
+			}
+		}
+	}
+}
+
+func TestModelWidth(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Model{}.New() didn't panic with an invalid width")
+		}
+	}()
+	Model{}.New()
+}
@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import "golang.org/x/text/unicode/norm"
+
+// ChecksumNFC returns the CRC-64 checksum of s after converting it to Unicode
+// Normalization Form C, so that canonically equivalent strings have the same checksum.
+// Normalization is provided by the golang.org/x/text/unicode/norm package.
+func (p *Poly) ChecksumNFC(s string) uint64 {
+	return p.ChecksumString(norm.NFC.String(s))
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import "testing"
+
+func TestChecksumNFC(t *testing.T) {
+	const (
+		composed   = "caf\u00e9"  // é as a single code point
+		decomposed = "cafe\u0301" // e followed by a combining acute accent
+	)
+	for _, p := range polys {
+		if a, b := p.ChecksumString(composed), p.ChecksumString(decomposed); a == b {
+			t.Errorf("Poly = 0x%016x; ChecksumString() of composed and decomposed forms are both 0x%016x", p.poly, a)
+		}
+		want := p.ChecksumString(composed)
+		if got := p.ChecksumNFC(composed); got != want {
+			t.Errorf("Poly = 0x%016x; ChecksumNFC(composed) = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+		if got := p.ChecksumNFC(decomposed); got != want {
+			t.Errorf("Poly = 0x%016x; ChecksumNFC(decomposed) = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+	}
+}
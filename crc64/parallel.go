@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	minShardSize = 256 << 10 // Minimum number of bytes hashed by a shard.
+	ctxChunkSize = 64 << 10  // Number of bytes hashed between checks for cancellation.
+)
+
+// ChecksumParallelContext returns the CRC-64 checksum of data, which is split into
+// the given number of shards that are hashed concurrently and then combined.
+// Small inputs are hashed with fewer shards, or serially, to avoid needless goroutines.
+// If ctx is done before hashing completes, it stops all shards and returns ctx.Err().
+// It doesn't return until all of the goroutines it started have exited.
+func (p *Poly) ChecksumParallelContext(ctx context.Context, data []byte, shards int) (uint64, error) {
+	shards = min(shards, len(data)/minShardSize)
+	if shards <= 1 {
+		return p.checksumContext(ctx, data)
+	}
+	var (
+		wg   sync.WaitGroup
+		sums = make([]uint64, shards)
+		errs = make([]error, shards)
+	)
+	wg.Add(shards)
+	for i := range shards {
+		go func() {
+			defer wg.Done()
+			sums[i], errs[i] = p.checksumContext(ctx, shard(data, i, shards))
+		}()
+	}
+	wg.Wait()
+	var sum uint64
+	for i, v := range sums {
+		if errs[i] != nil {
+			return 0, errs[i]
+		}
+		sum = p.Combine(sum, v, int64(len(shard(data, i, shards))))
+	}
+	return sum, nil
+}
+
+// ChecksumParallel returns the CRC-64 checksum of data, which is split into
+// the given number of shards that are hashed concurrently and then combined.
+// Small inputs are hashed with fewer shards, or serially, to avoid needless goroutines.
+func (p *Poly) ChecksumParallel(data []byte, shards int) uint64 {
+	sum, _ := p.ChecksumParallelContext(context.Background(), data, shards)
+	return sum
+}
+
+// shard returns the i-th of n roughly equal shards of data.
+func shard(data []byte, i, n int) []byte {
+	return data[i*len(data)/n : (i+1)*len(data)/n]
+}
+
+func (p *Poly) checksumContext(ctx context.Context, data []byte) (uint64, error) {
+	var sum uint64
+	for len(data) > 0 {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		n := min(len(data), ctxChunkSize)
+		sum = p.Update(sum, data[:n])
+		data = data[n:]
+	}
+	// Every chunk was hashed, so a deadline that passed since is no failure.
+	return sum, nil
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestPinnedNew(t *testing.T) {
+	data := randData(1000)
+	for _, p := range polys {
+		pinned := p.PinnedNew()
+		pinned.Write(data[:300])
+		state, err := pinned.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Poly = 0x%016x; MarshalBinary() failed: %v", p.poly, err)
+		}
+		resumed := p.PinnedNew()
+		if err := resumed.UnmarshalBinary(state); err != nil {
+			t.Fatalf("Poly = 0x%016x; UnmarshalBinary() failed: %v", p.poly, err)
+		}
+		resumed.Write(data[300:])
+		// Compare with the bitwise reference, since the standard library's tables
+		// are what PinnedNew mustn't depend on.
+		want := updateBits(p, 0, data, 8*len(data))
+		if got := resumed.Sum64(); got != want {
+			t.Errorf("Poly = 0x%016x; PinnedNew().Sum64() = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+		if got, want := resumed.Sum(nil), binary.BigEndian.AppendUint64(nil, want); !bytes.Equal(got, want) {
+			t.Errorf("Poly = 0x%016x; PinnedNew().Sum() = %x; want %x", p.poly, got, want)
+		}
+		resumed.Reset()
+		if got := resumed.Sum64(); got != 0 {
+			t.Errorf("Poly = 0x%016x; PinnedNew().Sum64() after Reset = 0x%016x; want 0", p.poly, got)
+		}
+	}
+}
+
+func TestPinnedNewState(t *testing.T) {
+	// The pinned format must never change.
+	h := ECMA().PinnedNew()
+	h.Write([]byte("123456789"))
+	state, _ := h.MarshalBinary()
+	if got, want := hex.EncodeToString(state), "6372635001c96c5795d7870f42995dc9bbdf1939fa"; got != want {
+		t.Errorf("MarshalBinary() = %s; want %s", got, want)
+	}
+
+	version := bytes.Clone(state)
+	version[len(pinnedMagic)]++
+	tests := []struct {
+		name  string
+		hash  Hash
+		state []byte
+		want  error
+	}{
+		{"empty", ECMA().PinnedNew(), nil, ErrInvalidState},
+		{"identifier", ECMA().PinnedNew(), append([]byte("bad!"), state[4:]...), ErrInvalidState},
+		{"version", ECMA().PinnedNew(), version, ErrInvalidState},
+		{"truncated", ECMA().PinnedNew(), state[:len(state)-1], ErrInvalidState},
+		{"mismatch", MakePoly(1).PinnedNew(), state, ErrStateMismatch},
+	}
+	for _, tt := range tests {
+		if err := tt.hash.UnmarshalBinary(tt.state); !errors.Is(err, tt.want) {
+			t.Errorf("%s: UnmarshalBinary() error = %v; want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"math/big"
+	"math/bits"
+	"slices"
+)
+
+// PolyProperties describes algebraic properties of a polynomial that affect its
+// ability to detect errors.
+type PolyProperties struct {
+	// Reducible reports whether the polynomial factors over GF(2).
+	Reducible bool
+
+	// DivisibleByXPlus1 reports whether the polynomial has x+1 as a factor,
+	// in which case it detects every error that flips an odd number of bits.
+	DivisibleByXPlus1 bool
+}
+
+// Properties returns the algebraic properties of the polynomial.
+func (p *Poly) Properties() PolyProperties {
+	return PolyProperties{
+		Reducible: p.IsReducible(),
+		// Including the implicit x^64 term, p(1) is the parity of the number of terms.
+		DivisibleByXPlus1: bits.OnesCount64(p.poly)%2 == 1,
+	}
+}
+
+// IsReducible reports whether the polynomial factors over GF(2). Polynomials that
+// factor only as x+1 times an irreducible polynomial are often chosen deliberately,
+// but other reducible polynomials usually indicate a poor choice.
+func (p *Poly) IsReducible() bool {
+	if p.poly&(1<<(nBits-1)) == 0 {
+		return true // divisible by x
+	}
+	// Rabin's test: p(x) of degree n = 2^k is irreducible if and only if
+	// x^(2^n) = x modulo p(x) and gcd(x^(2^(n/2)) - x, p(x)) = 1.
+	x := uint64(1) << (nBits - 2)
+	last := p.x2nTbl[nBits-1]
+	if p.multModP(last, last) != x {
+		return true
+	}
+	f := new(big.Int).SetUint64(uint64(bits.Reverse64(p.poly)))
+	f.SetBit(f, nBits, 1)
+	h := new(big.Int).SetUint64(uint64(bits.Reverse64(p.x2nTbl[nBits/2] ^ x)))
+	return gcdGF2(f, h).BitLen() != 1
+}
+
+// HammingDistance returns the Hamming distance of the CRC for data words of up to
+// maxLen bits, which is the minimum number of bit errors in a data word and its
+// checksum that can go undetected. It returns 0 if maxLen isn't positive.
+//
+// The distance is computed exactly by searching for undetected errors of increasing
+// weight, but only up to weight 5, which takes time cubic in the length; it returns
+// 6 if no such error exists, meaning the distance is at least 6. Searching for weight 4
+// takes memory quadratic in the length, so it's only practical for lengths of up to
+// tens of thousands of bits.
+func (p *Poly) HammingDistance(maxLen int) int {
+	if maxLen <= 0 {
+		return 0
+	}
+	// An error is undetected if the syndromes x^i modulo p(x) of its bits sum to zero.
+	n := maxLen + nBits
+	syn := make([]uint64, n)
+	v := uint64(1) << (nBits - 1) // x^0
+	for i := range syn {
+		if v == 0 {
+			return 1
+		}
+		syn[i] = v
+		xor := v&1 != 0
+		if v >>= 1; xor {
+			v ^= p.poly
+		}
+	}
+	// Errors that reduce to lower weights when their bits overlap have already been
+	// ruled out, so each weight only needs to match disjoint sets of bits.
+	if hasDup(slices.Sorted(slices.Values(syn))) {
+		return 2
+	}
+	pairs := make([]uint64, 0, n*(n-1)/2)
+	for i := range syn {
+		for j := i + 1; j < n; j++ {
+			pairs = append(pairs, syn[i]^syn[j])
+		}
+	}
+	slices.Sort(pairs)
+	for _, s := range syn {
+		if _, ok := slices.BinarySearch(pairs, s); ok {
+			return 3
+		}
+	}
+	if hasDup(pairs) {
+		return 4
+	}
+	for i := range syn {
+		for j := i + 1; j < n; j++ {
+			for k := j + 1; k < n; k++ {
+				if _, ok := slices.BinarySearch(pairs, syn[i]^syn[j]^syn[k]); ok {
+					return 5
+				}
+			}
+		}
+	}
+	return 6
+}
+
+// hasDup reports whether the sorted slice contains duplicates.
+func hasDup(s []uint64) bool {
+	for i := 1; i < len(s); i++ {
+		if s[i] == s[i-1] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import "testing"
+
+func TestProperties(t *testing.T) {
+	tests := []struct {
+		name string
+		poly *Poly
+		want PolyProperties
+	}{
+		{"irreducible", ISO(), PolyProperties{}},
+		{"x+1 factor", ECMA(), PolyProperties{Reducible: true, DivisibleByXPlus1: true}},
+		{"x^64+1", MakePoly(1 << (nBits - 1)), PolyProperties{Reducible: true, DivisibleByXPlus1: true}},
+		{"x factor", MakePoly(3), PolyProperties{Reducible: true}},
+	}
+	for _, tt := range tests {
+		if got := tt.poly.Properties(); got != tt.want {
+			t.Errorf("%s: Poly = 0x%016x; Properties() = %+v; want %+v", tt.name, tt.poly.poly, got, tt.want)
+		}
+		if got := tt.poly.IsReducible(); got != tt.want.Reducible {
+			t.Errorf("%s: Poly = 0x%016x; IsReducible() = %v; want %v", tt.name, tt.poly.poly, got, tt.want.Reducible)
+		}
+	}
+}
+
+func TestHammingDistance(t *testing.T) {
+	// x^64 + 1 doesn't detect two errors 64 bits apart.
+	if got := MakePoly(1 << 63).HammingDistance(1); got != 2 {
+		t.Errorf("MakePoly(0x8000000000000000).HammingDistance(1) = %d; want 2", got)
+	}
+	// ISO has only five terms, so it doesn't detect the error of its own polynomial.
+	if got := ISO().HammingDistance(1); got != 5 {
+		t.Errorf("ISO().HammingDistance(1) = %d; want 5", got)
+	}
+	if got := ECMA().HammingDistance(64); got != 6 {
+		t.Errorf("ECMA().HammingDistance(64) = %d; want 6", got)
+	}
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// A Limiter throttles reading to a rate in bytes per second. It's satisfied by
+// *rate.Limiter from golang.org/x/time/rate, without this package depending on it.
+type Limiter interface {
+	// Burst returns the most bytes that may be waited for at once,
+	// or zero if it isn't limited.
+	Burst() int
+	// WaitN blocks until n bytes may be read or ctx is done.
+	WaitN(ctx context.Context, n int) error
+}
+
+// ChecksumReaderLimited reads r until EOF and returns the CRC-64 checksum and number
+// of bytes read, waiting on the limiter after each read so that reading is throttled to
+// its rate. Reads are no larger than the limiter's burst size, if it's positive. If reading
+// or waiting fails, such as when ctx is done, it returns the error along with the checksum
+// and length of the bytes read before it.
+func (p *Poly) ChecksumReaderLimited(ctx context.Context, r io.Reader, limiter Limiter) (sum uint64, n int64, err error) {
+	buf := bufPool.Get().(*[]byte)
+	defer bufPool.Put(buf)
+	chunk := *buf
+	if burst := limiter.Burst(); burst > 0 {
+		chunk = chunk[:min(len(chunk), burst)]
+	}
+	for {
+		m, err := r.Read(chunk)
+		sum = p.Update(sum, chunk[:m])
+		n += int64(m)
+		if m > 0 {
+			if err := limiter.WaitN(ctx, m); err != nil {
+				return sum, n, err
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			return sum, n, err
+		}
+	}
+}
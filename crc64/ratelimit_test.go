@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestChecksumReaderLimited(t *testing.T) {
+	const (
+		limit = 1 << 20
+		burst = 16 << 10
+	)
+	p := polys[0]
+	data := randData(256 << 10)
+	want := p.Checksum(data)
+
+	start := time.Now()
+	sum, n, err := p.ChecksumReaderLimited(context.Background(), bytes.NewReader(data), rate.NewLimiter(limit, burst))
+	elapsed := time.Since(start)
+	if err != nil || sum != want || n != int64(len(data)) {
+		t.Errorf("ChecksumReaderLimited() = (0x%016x, %d, %v); want (0x%016x, %d, nil)", sum, n, err, want, len(data))
+	}
+	if min := time.Duration(len(data)-burst) * time.Second / limit * 9 / 10; elapsed < min {
+		t.Errorf("ChecksumReaderLimited() took %v; want at least %v", elapsed, min)
+	}
+
+	sum, n, err = p.ChecksumReaderLimited(context.Background(), bytes.NewReader(data), rate.NewLimiter(rate.Inf, 0))
+	if err != nil || sum != want || n != int64(len(data)) {
+		t.Errorf("ChecksumReaderLimited(Inf) = (0x%016x, %d, %v); want (0x%016x, %d, nil)", sum, n, err, want, len(data))
+	}
+	if _, _, err := p.ChecksumReaderLimited(context.Background(), bytes.NewReader(data), rate.NewLimiter(limit, 0)); err == nil {
+		t.Errorf("ChecksumReaderLimited(zero burst) succeeded; want error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	sum, n, err = p.ChecksumReaderLimited(ctx, bytes.NewReader(data), rate.NewLimiter(limit, burst))
+	if !errors.Is(err, context.Canceled) || n != burst || sum != p.Checksum(data[:burst]) {
+		t.Errorf("ChecksumReaderLimited(canceled) = (0x%016x, %d, %v); want (0x%016x, %d, %v)", sum, n, err, p.Checksum(data[:burst]), burst, context.Canceled)
+	}
+}
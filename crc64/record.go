@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"errors"
+	"io"
+)
+
+// EachRecordCRC reads r in records of recordSize bytes and calls yield with the index
+// and CRC-64 checksum of each record in order, until EOF or until yield returns false.
+// If the final record is short, its partial bytes are hashed and yielded like any other.
+// Records are read into a reused buffer, so it doesn't allocate for records no larger
+// than 32 KiB. It returns nil at EOF or when stopped early, otherwise the read error.
+func (p *Poly) EachRecordCRC(r io.Reader, recordSize int, yield func(index int64, crc uint64) bool) error {
+	if recordSize <= 0 {
+		return errors.New("crc64: non-positive record size")
+	}
+	var rec []byte
+	if recordSize <= bufSize {
+		buf := bufPool.Get().(*[]byte)
+		defer bufPool.Put(buf)
+		rec = (*buf)[:recordSize]
+	} else {
+		rec = make([]byte, recordSize)
+	}
+	for i := int64(0); ; i++ {
+		n, err := io.ReadFull(r, rec)
+		if n > 0 && !yield(i, p.Checksum(rec[:n])) {
+			return nil
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
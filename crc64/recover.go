@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// RecoverPoly returns the [Poly] of the reflected CRC-64, without initial or final
+// inversion, that produced each pair's sum from its data. It reports false if the pairs
+// are inconsistent or don't determine a unique polynomial, in which case more pairs,
+// or pairs with more data, may be needed.
+//
+// Each pair constrains the polynomial to divide M(x)*x^64 + S(x), where M(x) is the
+// message and S(x) the sum, so the polynomial is recovered as their greatest common divisor.
+func RecoverPoly(pairs []struct {
+	Data []byte
+	Sum  uint64
+}) (*Poly, bool) {
+	g := new(big.Int)
+	for _, pair := range pairs {
+		g = gcdGF2(g, messagePoly(pair.Data, pair.Sum))
+	}
+	if g.BitLen() != nBits+1 {
+		return nil, false
+	}
+	poly := bits.Reverse64(uint64(g.Uint64()))
+	if poly == 0 {
+		return nil, false
+	}
+	p := MakePoly(poly)
+	for _, pair := range pairs {
+		// Undo the inversion before and after the update.
+		if ^p.Update(^uint64(0), pair.Data) != pair.Sum {
+			return nil, false
+		}
+	}
+	return p, true
+}
+
+// messagePoly returns M(x)*x^64 + S(x) over GF(2) as a big.Int whose bit i is the
+// coefficient of x^i, where the first bit of data is the coefficient of the highest degree.
+func messagePoly(data []byte, sum uint64) *big.Int {
+	b := make([]byte, len(data)+Size)
+	for i, v := range data {
+		b[i] = bits.Reverse8(v)
+	}
+	for i := range Size {
+		b[len(data)+i] = bits.Reverse8(byte(sum >> (8 * i)))
+	}
+	return new(big.Int).SetBytes(b)
+}
+
+// gcdGF2 returns the greatest common divisor of a and b as polynomials over GF(2).
+// It may modify a and b.
+func gcdGF2(a, b *big.Int) *big.Int {
+	t := new(big.Int)
+	for b.Sign() != 0 {
+		for n := b.BitLen(); a.BitLen() >= n; {
+			a.Xor(a, t.Lsh(b, uint(a.BitLen()-n)))
+		}
+		a, b = b, a
+	}
+	return a
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestRendezvous(t *testing.T) {
+	var nodes [][]byte
+	for i := range 8 {
+		nodes = append(nodes, []byte(fmt.Sprintf("node-%d", i)))
+	}
+	for _, p := range polys {
+		if got := p.Rendezvous([]byte("key"), nil); got != -1 {
+			t.Errorf("Poly = 0x%016x; Rendezvous(key, nil) = %d; want -1", p.poly, got)
+		}
+		for i := range 256 {
+			key := []byte(fmt.Sprintf("key-%d", i))
+			idx := p.Rendezvous(key, nodes)
+			if got := p.Rendezvous(key, nodes); got != idx {
+				t.Fatalf("Poly = 0x%016x; Rendezvous(%q) = %d, then %d", p.poly, key, idx, got)
+			}
+			for j := range nodes {
+				if j == idx {
+					continue // Keys of a removed node may move to any remaining node.
+				}
+				rest := slices.Delete(slices.Clone(nodes), j, j+1)
+				if got := rest[p.Rendezvous(key, rest)]; !bytes.Equal(got, nodes[idx]) {
+					t.Errorf("Poly = 0x%016x; Rendezvous(%q) without %q = %q; want %q", p.poly, key, nodes[j], got, nodes[idx])
+				}
+			}
+		}
+	}
+}
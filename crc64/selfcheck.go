@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"fmt"
+	"hash/crc64"
+	"sync/atomic"
+)
+
+var selfCheckEnabled atomic.Bool
+
+// EnableSelfCheck enables verification of each [Poly] when it's constructed, including
+// the named polynomials, which are constructed on first use. A Poly's checksum is compared
+// to its published check value, if it's a named polynomial, and its tables are checked for
+// consistency with each other. Construction panics if a check fails, which indicates memory
+// corruption or a miscompiled binary. Self-checks are disabled by default to avoid their cost.
+func EnableSelfCheck() {
+	selfCheckEnabled.Store(true)
+}
+
+// checkValues are the checksums of "123456789" for the named polynomials.
+var checkValues = map[uint64]uint64{
+	crc64.ISO:  0xb90956c775a41001,
+	crc64.ECMA: 0x995dc9bbdf1939fa,
+}
+
+// selfCheck panics if self-checks are enabled and the Poly fails them.
+func (p *Poly) selfCheck() {
+	if !selfCheckEnabled.Load() {
+		return
+	}
+	data := []byte("123456789")
+	sum := p.Checksum(data)
+	if want, ok := checkValues[p.poly]; ok && sum != want {
+		panic(fmt.Sprintf("crc64: self-check failed: Poly 0x%016x has check value 0x%016x; want 0x%016x", p.poly, sum, want))
+	}
+	if got := p.Combine(p.Checksum(data[:5]), p.Checksum(data[5:]), 4); got != sum {
+		panic(fmt.Sprintf("crc64: self-check failed: Poly 0x%016x combines to 0x%016x; want 0x%016x", p.poly, got, sum))
+	}
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import "testing"
+
+func TestSelfCheck(t *testing.T) {
+	defer selfCheckEnabled.Store(selfCheckEnabled.Load())
+	EnableSelfCheck()
+	for poly := range checkValues {
+		makePoly(poly)
+	}
+	for _, p := range polys {
+		makePoly(p.poly)
+	}
+
+	poly := polys[len(polys)-1].poly
+	checkValues[poly] = 0
+	defer delete(checkValues, poly)
+	defer func() {
+		if recover() == nil {
+			t.Errorf("makePoly(0x%016x) with corrupt check value didn't panic", poly)
+		}
+	}()
+	makePoly(poly)
+}
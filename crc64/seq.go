@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import "iter"
+
+// CombineSeq returns the result of combining, in order, the sums yielded by seq,
+// each paired with the number of bytes it covers. It returns zero for an empty sequence.
+func (p *Poly) CombineSeq(seq iter.Seq2[uint64, int64]) uint64 {
+	var sum uint64
+	for next, n := range seq {
+		sum = p.Combine(sum, next, n)
+	}
+	return sum
+}
+
+// CombineAll returns the result of combining, in order, the sums, each covering
+// the corresponding number of bytes in lens. It returns zero if there are no sums.
+// It panics if sums and lens have different lengths.
+func (p *Poly) CombineAll(sums []uint64, lens []int64) uint64 {
+	if len(sums) != len(lens) {
+		panic("crc64: mismatched sums and lengths")
+	}
+	var sum uint64
+	for i, next := range sums {
+		sum = p.Combine(sum, next, lens[i])
+	}
+	return sum
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import "testing"
+
+func TestCombineSeq(t *testing.T) {
+	data := randData(1000)
+	chunks := [][]byte{data[:0], data[:1], data[1:100], data[100:100], data[100:]}
+	for _, p := range polys {
+		seq := func(yield func(uint64, int64) bool) {
+			for _, c := range chunks {
+				if !yield(p.Checksum(c), int64(len(c))) {
+					return
+				}
+			}
+		}
+		if got, want := p.CombineSeq(seq), p.Checksum(data); got != want {
+			t.Errorf("Poly = 0x%016x; CombineSeq() = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+		empty := func(yield func(uint64, int64) bool) {}
+		if got := p.CombineSeq(empty); got != 0 {
+			t.Errorf("Poly = 0x%016x; CombineSeq(empty) = 0x%016x; want 0", p.poly, got)
+		}
+	}
+}
+
+func TestCombineAll(t *testing.T) {
+	data := randData(1000)
+	chunks := [][]byte{data[:0], data[:1], data[1:100], data[100:100], data[100:]}
+	for _, p := range polys {
+		var sums []uint64
+		var lens []int64
+		for _, c := range chunks {
+			sums = append(sums, p.Checksum(c))
+			lens = append(lens, int64(len(c)))
+		}
+		if got, want := p.CombineAll(sums, lens), p.Checksum(data); got != want {
+			t.Errorf("Poly = 0x%016x; CombineAll() = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+		if got := p.CombineAll(nil, nil); got != 0 {
+			t.Errorf("Poly = 0x%016x; CombineAll(nil, nil) = 0x%016x; want 0", p.poly, got)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("CombineAll() with mismatched lengths didn't panic")
+		}
+	}()
+	polys[0].CombineAll(make([]uint64, 2), make([]int64, 1))
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+// Shingles returns the CRC-64 checksum of each k-byte window of data in order,
+// which is useful for near-duplicate detection. It computes each checksum from
+// the previous one in constant time. It returns nil if k isn't positive or if k
+// is larger than the length of data.
+func (p *Poly) Shingles(data []byte, k int) []uint64 {
+	if k <= 0 || k > len(data) {
+		return nil
+	}
+	sums := make([]uint64, 0, len(data)-k+1)
+	p.ShinglesFunc(data, k, func(_ int64, sum uint64) bool {
+		sums = append(sums, sum)
+		return true
+	})
+	return sums
+}
+
+// ShinglesFunc calls yield with the offset and CRC-64 checksum of each k-byte
+// window of data in order, like [Poly.Shingles], but in constant memory. It stops
+// early if yield returns false. It doesn't call yield if k isn't positive or if k
+// is larger than the length of data.
+func (p *Poly) ShinglesFunc(data []byte, k int, yield func(offset int64, crc uint64) bool) {
+	if k <= 0 || k > len(data) {
+		return
+	}
+	sum := p.Checksum(data[:k])
+	if !yield(0, sum) || k == len(data) {
+		return
+	}
+	r := p.newRoller(k)
+	for i := k; i < len(data); i++ {
+		sum = r.roll(sum, data[i-k], data[i:i+1])
+		if !yield(int64(i-k+1), sum) {
+			return
+		}
+	}
+}
+
+// MinHashSketch returns, for each of the polys, the minimum CRC-64 checksum of
+// all k-byte windows of data. It returns nil if k isn't positive or if k is larger
+// than the length of data.
+//
+// Each [Poly] acts as an independent hash function, so the sketch is a MinHash
+// of the set of windows: the fraction of positions at which the sketches of two
+// inputs are equal estimates the Jaccard similarity of their sets of windows.
+// The estimate's accuracy improves with the number of polys.
+func MinHashSketch(data []byte, k int, polys []*Poly) []uint64 {
+	if k <= 0 || k > len(data) {
+		return nil
+	}
+	sketch := make([]uint64, len(polys))
+	for j, p := range polys {
+		low := ^uint64(0)
+		p.ShinglesFunc(data, k, func(_ int64, sum uint64) bool {
+			low = min(low, sum)
+			return true
+		})
+		sketch[j] = low
+	}
+	return sketch
+}
+
+// roller slides a fixed-size window along data one byte at a time.
+type roller struct {
+	poly *Poly
+	out  [256]uint64
+}
+
+func (p *Poly) newRoller(k int) *roller {
+	r := &roller{poly: p}
+	// The checksum of a window is the combination of its first byte with the rest,
+	// so removing the byte leaves the checksum of the rest.
+	x := p.x2NModP(int64(k-1), 3)
+	for b := range r.out {
+		r.out[b] = p.multModP(x, p.Checksum([]byte{byte(b)}))
+	}
+	return r
+}
+
+// roll returns the sum of the window with out removed from its front
+// and in, which must be a single byte, added to its back.
+func (r *roller) roll(sum uint64, out byte, in []byte) uint64 {
+	return r.poly.Update(sum^r.out[out], in)
+}
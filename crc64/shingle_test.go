@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestShingles(t *testing.T) {
+	data := randData(300)
+	for _, p := range polys {
+		for _, k := range []int{1, 2, 7, 64, 299, 300} {
+			got := p.Shingles(data, k)
+			if len(got) != len(data)-k+1 {
+				t.Fatalf("Poly = 0x%016x; len(Shingles(data, %d)) = %d; want %d", p.poly, k, len(got), len(data)-k+1)
+			}
+			for i, sum := range got {
+				if want := p.Checksum(data[i : i+k]); sum != want {
+					t.Errorf("Poly = 0x%016x; Shingles(data, %d)[%d] = 0x%016x; want 0x%016x", p.poly, k, i, sum, want)
+				}
+			}
+		}
+		for _, k := range []int{-1, 0, 301} {
+			if got := p.Shingles(data, k); len(got) != 0 {
+				t.Errorf("Poly = 0x%016x; len(Shingles(data, %d)) = %d; want 0", p.poly, k, len(got))
+			}
+		}
+	}
+}
+
+func TestMinHashSketch(t *testing.T) {
+	const k = 8
+	data := randData(4096)
+	sketch := MinHashSketch(data, k, polys)
+	for i, p := range polys {
+		if want := slices.Min(p.Shingles(data, k)); sketch[i] != want {
+			t.Errorf("Poly = 0x%016x; MinHashSketch(data, %d, polys)[%d] = 0x%016x; want 0x%016x", p.poly, k, i, sketch[i], want)
+		}
+	}
+	if got := MinHashSketch(data, k, polys); !slices.Equal(got, sketch) {
+		t.Errorf("MinHashSketch(data, %d, polys) = %x; want %x", k, got, sketch)
+	}
+
+	similar := slices.Clone(data)
+	similar[len(similar)/2]++
+	if n := countEqual(MinHashSketch(similar, k, polys), sketch); n < len(polys)-1 {
+		t.Errorf("MinHashSketch of similar data has %d equal values; want at least %d", n, len(polys)-1)
+	}
+	different := slices.Clone(data)
+	slices.Reverse(different)
+	if n := countEqual(MinHashSketch(different, k, polys), sketch); n > 1 {
+		t.Errorf("MinHashSketch of different data has %d equal values; want at most 1", n)
+	}
+
+	if got := MinHashSketch(data[:k-1], k, polys); got != nil {
+		t.Errorf("MinHashSketch(data[:%d], %d, polys) = %x; want nil", k-1, k, got)
+	}
+}
+
+func countEqual(a, b []uint64) int {
+	n := 0
+	for i := range a {
+		if a[i] == b[i] {
+			n++
+		}
+	}
+	return n
+}
+
+func TestShinglesFunc(t *testing.T) {
+	data := randData(300)
+	for _, p := range polys {
+		for _, k := range []int{0, 1, 7, 300, 301} {
+			want := p.Shingles(data, k)
+			var got []uint64
+			p.ShinglesFunc(data, k, func(off int64, sum uint64) bool {
+				if off != int64(len(got)) {
+					t.Errorf("Poly = 0x%016x; ShinglesFunc(data, %d) yielded offset %d; want %d", p.poly, k, off, len(got))
+				}
+				got = append(got, sum)
+				return true
+			})
+			if !slices.Equal(got, want) {
+				t.Errorf("Poly = 0x%016x; ShinglesFunc(data, %d) yielded %x; want %x", p.poly, k, got, want)
+			}
+		}
+
+		n := 0
+		p.ShinglesFunc(data, 8, func(int64, uint64) bool {
+			n++
+			return n < 5
+		})
+		if n != 5 {
+			t.Errorf("Poly = 0x%016x; ShinglesFunc() yielded %d times after stopping at 5", p.poly, n)
+		}
+	}
+}
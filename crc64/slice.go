@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import "unsafe"
+
+// ChecksumSlice returns the CRC-64 checksum of the memory backing s without copying it.
+//
+// The bytes are hashed as laid out in memory, so the checksum depends on the host's
+// byte order and on any padding in T. It's only portable for T without padding
+// and only between hosts with the same byte order.
+func ChecksumSlice[T any](p *Poly, s []T) uint64 {
+	if len(s) == 0 {
+		return p.Checksum(nil)
+	}
+	n := len(s) * int(unsafe.Sizeof(s[0]))
+	return p.Checksum(unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(s))), n))
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func TestChecksumSlice(t *testing.T) {
+	u32s := []uint32{0, 1, 0xdeadbeef, math.MaxUint32}
+	var b32 []byte
+	for _, v := range u32s {
+		b32 = binary.NativeEndian.AppendUint32(b32, v)
+	}
+	f64s := []float64{0, -1, math.Pi, math.Inf(1)}
+	var b64 []byte
+	for _, v := range f64s {
+		b64 = binary.NativeEndian.AppendUint64(b64, math.Float64bits(v))
+	}
+	for _, p := range polys {
+		if got, want := ChecksumSlice(p, u32s), p.Checksum(b32); got != want {
+			t.Errorf("Poly = 0x%016x; ChecksumSlice([]uint32) = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+		if got, want := ChecksumSlice(p, f64s), p.Checksum(b64); got != want {
+			t.Errorf("Poly = 0x%016x; ChecksumSlice([]float64) = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+		if got, want := ChecksumSlice(p, []uint32(nil)), p.Checksum(nil); got != want {
+			t.Errorf("Poly = 0x%016x; ChecksumSlice(nil) = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+		if got, want := ChecksumSlice(p, []byte("hello")), p.Checksum([]byte("hello")); got != want {
+			t.Errorf("Poly = 0x%016x; ChecksumSlice([]byte) = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"io"
+	"os"
+)
+
+// ChecksumSparseFile returns the CRC-64 checksum of the contents of f.
+//
+// Where the platform supports SEEK_DATA and SEEK_HOLE, only the data extents of
+// a sparse file are read and each hole's contribution is computed in O(log n) time.
+// Otherwise, the whole file is read. The file is read from its start regardless of
+// its current offset, and the offset is undefined afterward.
+func (p *Poly) ChecksumSparseFile(f *os.File) (uint64, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	size := fi.Size()
+	var sum uint64
+	for off := int64(0); off < size; {
+		data, hole, err := nextExtent(f, off, size)
+		if err != nil {
+			return 0, err
+		}
+		sum = p.ExtendZeros(sum, data-off)
+		next, n, err := p.ChecksumReader(io.NewSectionReader(f, data, hole-data))
+		if err != nil {
+			return 0, err
+		}
+		if n != hole-data {
+			return 0, io.ErrUnexpectedEOF
+		}
+		sum = p.Combine(sum, next, n)
+		off = hole
+	}
+	return sum, nil
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+//go:build darwin || freebsd || linux
+
+package crc64
+
+import (
+	"errors"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// nextExtent returns the bounds of the next extent of data in f at or after off.
+// If there's no more data, both bounds are size.
+func nextExtent(f *os.File, off, size int64) (data, hole int64, err error) {
+	data, err = f.Seek(off, unix.SEEK_DATA)
+	switch {
+	case errors.Is(err, unix.ENXIO):
+		return size, size, nil
+	case errors.Is(err, unix.EINVAL), errors.Is(err, unix.ENOTSUP):
+		return off, size, nil // Unsupported, so treat the rest as data.
+	case err != nil:
+		return 0, 0, err
+	}
+	hole, err = f.Seek(data, unix.SEEK_HOLE)
+	if err != nil {
+		return 0, 0, err
+	}
+	return data, min(hole, size), nil
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestChecksumSparseFile(t *testing.T) {
+	data := randData(100)
+	tests := []struct {
+		name  string
+		write func(f *os.File) error
+	}{
+		{"empty", func(f *os.File) error { return nil }},
+		{"dense", func(f *os.File) error {
+			_, err := f.Write(data)
+			return err
+		}},
+		{"holes", func(f *os.File) error {
+			if _, err := f.WriteAt(data, 0); err != nil {
+				return err
+			}
+			if _, err := f.WriteAt(data, 1<<20); err != nil {
+				return err
+			}
+			return f.Truncate(3 << 20)
+		}},
+		{"leading hole", func(f *os.File) error {
+			_, err := f.WriteAt(data, 1<<20)
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		f, err := os.Create(filepath.Join(t.TempDir(), "file"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		if err := tt.write(f); err != nil {
+			t.Fatal(err)
+		}
+		contents, err := os.ReadFile(f.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range polys {
+			got, err := p.ChecksumSparseFile(f)
+			if err != nil {
+				t.Fatalf("Poly = 0x%016x; %s: ChecksumSparseFile() failed: %v", p.poly, tt.name, err)
+			}
+			if want := p.Checksum(contents); got != want {
+				t.Errorf("Poly = 0x%016x; %s: ChecksumSparseFile() = 0x%016x; want 0x%016x", p.poly, tt.name, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"bytes"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+func TestChecksumReaderStats(t *testing.T) {
+	data := randData(3*bufSize + 5)
+	for _, p := range polys {
+		sum, stats, err := p.ChecksumReaderStats(iotest.OneByteReader(bytes.NewReader(data)))
+		if err != nil {
+			t.Fatalf("Poly = 0x%016x; ChecksumReaderStats() failed: %v", p.poly, err)
+		}
+		if want := p.Checksum(data); sum != want {
+			t.Errorf("Poly = 0x%016x; ChecksumReaderStats() = 0x%016x; want 0x%016x", p.poly, sum, want)
+		}
+		if stats.Bytes != int64(len(data)) {
+			t.Errorf("Poly = 0x%016x; ChecksumReaderStats() Bytes = %d; want %d", p.poly, stats.Bytes, len(data))
+		}
+		if stats.Duration <= 0 || stats.Throughput() <= 0 {
+			t.Errorf("Poly = 0x%016x; ChecksumReaderStats() = %+v with Throughput() = %v; want positive", p.poly, stats, stats.Throughput())
+		}
+	}
+}
+
+func TestChecksumStatsThroughput(t *testing.T) {
+	tests := []struct {
+		stats ChecksumStats
+		want  float64
+	}{
+		{ChecksumStats{Bytes: 1000, Duration: 2 * time.Second}, 500},
+		{ChecksumStats{Bytes: 1000}, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.stats.Throughput(); got != tt.want {
+			t.Errorf("%+v.Throughput() = %v; want %v", tt.stats, got, tt.want)
+		}
+	}
+}
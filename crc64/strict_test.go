@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewStrict(t *testing.T) {
+	src := NewStrict(ISO())
+	src.Write([]byte("hello, world"))
+	state, err := src.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+
+	dst := NewStrict(ISO())
+	if err := dst.UnmarshalBinary(state); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+	if got, want := dst.Sum64(), src.Sum64(); got != want {
+		t.Errorf("Sum64() = 0x%016x; want 0x%016x", got, want)
+	}
+
+	tests := []struct {
+		name  string
+		hash  Hash
+		state []byte
+		want  error
+	}{
+		{"empty", NewStrict(ISO()), nil, ErrInvalidState},
+		{"identifier", NewStrict(ISO()), append([]byte("bad!"), state[stateMagicLen:]...), ErrInvalidState},
+		{"truncated", NewStrict(ISO()), state[:len(state)-1], ErrInvalidState},
+		{"extended", NewStrict(ISO()), append(state[:len(state):len(state)], 0), ErrInvalidState},
+		{"mismatch", NewStrict(ECMA()), state, ErrStateMismatch},
+	}
+	for _, tt := range tests {
+		if err := tt.hash.UnmarshalBinary(tt.state); !errors.Is(err, tt.want) {
+			t.Errorf("%s: UnmarshalBinary() = %v; want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc64"
+	"os"
+)
+
+// ErrInvalidTable is returned when loading a table file that is malformed, truncated, or corrupt.
+var ErrInvalidTable = errors.New("crc64: invalid table file")
+
+const (
+	tableMagic   = "crc64tb\x01"
+	tableFileLen = len(tableMagic) + Size*(1+nBits+256) + Size
+)
+
+// SaveTable writes the polynomial and its tables to the named file,
+// so that they may be loaded by other processes with [LoadPolyTable].
+// The file is a portable big-endian encoding, not an in-memory image,
+// so it can't be mapped into memory and shared between processes.
+func (p *Poly) SaveTable(path string) error {
+	b := make([]byte, 0, tableFileLen)
+	b = append(b, tableMagic...)
+	b = binary.BigEndian.AppendUint64(b, p.poly)
+	for _, v := range p.x2nTbl {
+		b = binary.BigEndian.AppendUint64(b, v)
+	}
+	for _, v := range p.stdlib {
+		b = binary.BigEndian.AppendUint64(b, v)
+	}
+	b = binary.BigEndian.AppendUint64(b, ECMA().Checksum(b))
+	return os.WriteFile(path, b, 0o644)
+}
+
+// LoadPolyTable returns a [Poly] with the polynomial and tables read from the named file,
+// which must have been written by [Poly.SaveTable], without recomputing its tables.
+// The file is read into memory private to the process. It returns an error wrapping
+// [ErrInvalidTable] if the file is malformed or its checksum doesn't match, but it only
+// spot-checks the tables themselves, so the file must be trusted. Unlike [MakePoly],
+// the returned [Poly] isn't cached, but it may be shared and must not be modified.
+func LoadPolyTable(path string) (*Poly, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != tableFileLen || string(b[:len(tableMagic)]) != tableMagic {
+		return nil, fmt.Errorf("%w: unknown format", ErrInvalidTable)
+	}
+	body, trailer := b[:len(b)-Size], b[len(b)-Size:]
+	if ECMA().Checksum(body) != binary.BigEndian.Uint64(trailer) {
+		return nil, fmt.Errorf("%w: checksum mismatch", ErrInvalidTable)
+	}
+	body = body[len(tableMagic):]
+	p := &Poly{
+		poly:   binary.BigEndian.Uint64(body),
+		stdlib: new(crc64.Table),
+	}
+	body = body[Size:]
+	for i := range p.x2nTbl {
+		p.x2nTbl[i] = binary.BigEndian.Uint64(body[i*Size:])
+	}
+	body = body[nBits*Size:]
+	for i := range p.stdlib {
+		p.stdlib[i] = binary.BigEndian.Uint64(body[i*Size:])
+	}
+	// The table entry for the top bit is the polynomial and the first power is x^1.
+	if p.poly == 0 || p.stdlib[0x80] != p.poly || p.x2nTbl[0] != 1<<(nBits-2) {
+		return nil, fmt.Errorf("%w: inconsistent polynomial", ErrInvalidTable)
+	}
+	switch p.poly {
+	case crc64.ISO, crc64.ECMA:
+		// Named polynomials are shared and may have accelerated implementations.
+		return MakePoly(p.poly), nil
+	}
+	p.selfCheck()
+	return p, nil
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// ResumableHash is a [Hash] that tracks the number of bytes written to it and
+// can produce a compact token of its state from which hashing may be resumed.
+type ResumableHash interface {
+	Hash
+
+	// Len returns the number of bytes written since the hash was created or reset.
+	Len() int64
+
+	// Token returns a URL-safe base64 encoding of the state of the hash,
+	// which identifies its polynomial and includes its running sum and length.
+	Token() string
+}
+
+// NewResumable creates a new [ResumableHash] computing the CRC-64 checksum
+// using the polynomial represented by the [Poly].
+func NewResumable(p *Poly) ResumableHash {
+	return &resumable{poly: p}
+}
+
+// Resume creates a new [ResumableHash] computing the CRC-64 checksum using the
+// polynomial represented by the [Poly] with the state encoded in the token.
+// If the token is invalid, the returned error wraps [ErrInvalidState].
+// If it was produced with a different polynomial, the error is [ErrStateMismatch].
+func (p *Poly) Resume(token string) (ResumableHash, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidState, err)
+	}
+	d := &resumable{poly: p}
+	if err := d.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// The resumable state is laid out as a version, the polynomial, the running sum,
+// and the length as a uvarint.
+const (
+	resumableVersion = 1
+	resumableMinSize = 1 + 2*Size + 1
+)
+
+type resumable struct {
+	poly *Poly
+	sum  uint64
+	n    int64
+}
+
+func (d *resumable) Size() int      { return Size }
+func (d *resumable) BlockSize() int { return 1 }
+func (d *resumable) Reset()         { d.sum, d.n = 0, 0 }
+func (d *resumable) Sum64() uint64  { return d.sum }
+func (d *resumable) Len() int64     { return d.n }
+
+func (d *resumable) Write(b []byte) (int, error) {
+	d.sum = d.poly.Update(d.sum, b)
+	d.n += int64(len(b))
+	return len(b), nil
+}
+
+func (d *resumable) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint64(b, d.sum)
+}
+
+func (d *resumable) Token() string {
+	b, _ := d.MarshalBinary()
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func (d *resumable) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, 1+2*Size+binary.MaxVarintLen64)
+	b = append(b, resumableVersion)
+	b = binary.BigEndian.AppendUint64(b, d.poly.poly)
+	b = binary.BigEndian.AppendUint64(b, d.sum)
+	return binary.AppendUvarint(b, uint64(d.n)), nil
+}
+
+func (d *resumable) UnmarshalBinary(b []byte) error {
+	if len(b) < resumableMinSize || b[0] != resumableVersion {
+		return fmt.Errorf("%w: unknown identifier", ErrInvalidState)
+	}
+	if binary.BigEndian.Uint64(b[1:]) != d.poly.poly {
+		return ErrStateMismatch
+	}
+	sum := binary.BigEndian.Uint64(b[1+Size:])
+	n, k := binary.Uvarint(b[1+2*Size:])
+	if k <= 0 || 1+2*Size+k != len(b) || n > math.MaxInt64 {
+		return fmt.Errorf("%w: invalid length", ErrInvalidState)
+	}
+	d.sum, d.n = sum, int64(n)
+	return nil
+}
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"slices"
+	"testing"
+)
+
+func TestCheckAgainst(t *testing.T) {
+	for _, data := range [][]byte{nil, []byte("123456789")} {
+		for _, p := range polys {
+			sum := p.Checksum(data)
+			for _, want := range []uint64{sum, sum ^ 1} {
+				ok, got := p.CheckAgainst(data, want)
+				if ok != (want == sum) || got != sum {
+					t.Errorf("Poly = 0x%016x; CheckAgainst(%q, 0x%016x) = (%v, 0x%016x); want (%v, 0x%016x)", p.poly, data, want, ok, got, want == sum, sum)
+				}
+			}
+		}
+	}
+}
+
+func TestLooksAlreadyTerminated(t *testing.T) {
+	for _, p := range polys {
+		for _, data := range [][]byte{nil, []byte("123456789"), randData(1000)} {
+			sum := p.Checksum(data)
+			if p.LooksAlreadyTerminated(data) {
+				t.Errorf("Poly = 0x%016x; LooksAlreadyTerminated(%d bytes of data) = true; want false", p.poly, len(data))
+			}
+			be := binary.BigEndian.AppendUint64(data[:len(data):len(data)], sum)
+			le := binary.LittleEndian.AppendUint64(data[:len(data):len(data)], sum)
+			for _, terminated := range [][]byte{be, le} {
+				if !p.LooksAlreadyTerminated(terminated) {
+					t.Errorf("Poly = 0x%016x; LooksAlreadyTerminated(%d bytes of data + %x) = false; want true", p.poly, len(data), terminated[len(data):])
+				}
+			}
+		}
+	}
+}
+
+func TestVerifyChain(t *testing.T) {
+	data := randData(1000)
+	cuts := []int{0, 10, 10, 250, 999, 1000}
+	for _, p := range polys {
+		var sums, totals []uint64
+		var lens []int64
+		for i := 1; i < len(cuts); i++ {
+			seg := data[cuts[i-1]:cuts[i]]
+			sums = append(sums, p.Checksum(seg))
+			lens = append(lens, int64(len(seg)))
+			totals = append(totals, p.Checksum(data[:cuts[i]]))
+		}
+		total := p.Checksum(data)
+		for _, running := range [][]uint64{nil, totals} {
+			if i, ok := p.VerifyChain(sums, lens, running, total); !ok || i != -1 {
+				t.Errorf("Poly = 0x%016x; VerifyChain(running = %v) = (%d, %v); want (-1, true)", p.poly, running != nil, i, ok)
+			}
+			if i, ok := p.VerifyChain(sums, lens, running, total^1); ok || i != -1 {
+				t.Errorf("Poly = 0x%016x; VerifyChain(running = %v, wrong total) = (%d, %v); want (-1, false)", p.poly, running != nil, i, ok)
+			}
+			if i, ok := p.VerifyChain(sums, lens[:3], running, total); ok || i != 3 {
+				t.Errorf("Poly = 0x%016x; VerifyChain(running = %v, short lens) = (%d, %v); want (3, false)", p.poly, running != nil, i, ok)
+			}
+		}
+
+		bad := slices.Clone(sums)
+		bad[3] ^= 1
+		if i, ok := p.VerifyChain(bad, lens, nil, total); ok || i != -1 {
+			t.Errorf("Poly = 0x%016x; VerifyChain(corrupt) = (%d, %v); want (-1, false)", p.poly, i, ok)
+		}
+		if i, ok := p.VerifyChain(bad, lens, totals, total); ok || i != 3 {
+			t.Errorf("Poly = 0x%016x; VerifyChain(corrupt, running) = (%d, %v); want (3, false)", p.poly, i, ok)
+		}
+		if i, ok := p.VerifyChain(sums, lens, totals[:2], total); ok || i != 2 {
+			t.Errorf("Poly = 0x%016x; VerifyChain(short running) = (%d, %v); want (2, false)", p.poly, i, ok)
+		}
+	}
+}
+
+func TestVerifyEmbedded(t *testing.T) {
+	for _, p := range polys {
+		for _, order := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+			for _, off := range []int{0, 12, 100 - Size} {
+				packet := randData(100)
+				zeroed := slices.Clone(packet)
+				clear(zeroed[off : off+Size])
+				order.PutUint64(packet[off:], p.Checksum(zeroed))
+				orig := slices.Clone(packet)
+				if ok, err := p.VerifyEmbedded(packet, off, order); !ok || err != nil {
+					t.Errorf("Poly = 0x%016x; VerifyEmbedded(%d, %v) = (%v, %v); want (true, nil)", p.poly, off, order, ok, err)
+				}
+				if !bytes.Equal(packet, orig) {
+					t.Errorf("Poly = 0x%016x; VerifyEmbedded(%d, %v) modified packet", p.poly, off, order)
+				}
+				packet[(off+Size)%len(packet)] ^= 1
+				if ok, err := p.VerifyEmbedded(packet, off, order); ok || err != nil {
+					t.Errorf("Poly = 0x%016x; VerifyEmbedded(corrupt, %d, %v) = (%v, %v); want (false, nil)", p.poly, off, order, ok, err)
+				}
+			}
+		}
+		for _, off := range []int{-1, 100 - Size + 1} {
+			if _, err := p.VerifyEmbedded(make([]byte, 100), off, binary.BigEndian); err == nil {
+				t.Errorf("Poly = 0x%016x; VerifyEmbedded(%d) succeeded; want error", p.poly, off)
+			}
+		}
+	}
+}
+
+func TestVerifyDetailed(t *testing.T) {
+	data := []byte("123456789")
+	for _, p := range polys {
+		sum := p.Checksum(data)
+		if got, want := p.VerifyDetailed(data, sum), (VerifyResult{OK: true, Got: sum, Want: sum, Len: 9}); got != want {
+			t.Errorf("Poly = 0x%016x; VerifyDetailed(match) = %+v; want %+v", p.poly, got, want)
+		} else if got.Error() != "" {
+			t.Errorf("Poly = 0x%016x; VerifyDetailed(match).Error() = %q; want empty", p.poly, got.Error())
+		}
+		got := p.VerifyDetailed(data, ^sum)
+		if want := (VerifyResult{Got: sum, Want: ^sum, Len: 9}); got != want {
+			t.Errorf("Poly = 0x%016x; VerifyDetailed(mismatch) = %+v; want %+v", p.poly, got, want)
+		}
+		if want := fmt.Sprintf("crc64: checksum mismatch: got 0x%016x, want 0x%016x over 9 bytes", sum, ^sum); got.Error() != want {
+			t.Errorf("Poly = 0x%016x; VerifyDetailed(mismatch).Error() = %q; want %q", p.poly, got.Error(), want)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	for _, p := range polys {
+		for _, data := range [][]byte{nil, []byte("123456789"), randData(3*bufSize + 11)} {
+			want := p.Checksum(data)
+			if !p.Verify(data, want) {
+				t.Errorf("Poly = 0x%016x; Verify(%d bytes, 0x%016x) = false; want true", p.poly, len(data), want)
+			}
+			if p.Verify(data, want^1) {
+				t.Errorf("Poly = 0x%016x; Verify(%d bytes, 0x%016x) = true; want false", p.poly, len(data), want^1)
+			}
+
+			v := p.NewVerifier(want)
+			if n, err := io.Copy(v, bytes.NewReader(data)); err != nil || n != int64(len(data)) {
+				t.Fatalf("Poly = 0x%016x; io.Copy(Verifier, %d bytes) = (%d, %v)", p.poly, len(data), n, err)
+			}
+			if !v.Valid() || v.Sum64() != want {
+				t.Errorf("Poly = 0x%016x; Verifier(%d bytes).Valid() = false; Sum64() = 0x%016x; want 0x%016x", p.poly, len(data), v.Sum64(), want)
+			}
+			bad := p.NewVerifier(want ^ 1)
+			bad.Write(data)
+			if bad.Valid() {
+				t.Errorf("Poly = 0x%016x; Verifier(%d bytes, 0x%016x).Valid() = true; want false", p.poly, len(data), want^1)
+			}
+		}
+	}
+}
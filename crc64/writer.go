@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import "encoding/binary"
+
+// ChecksumBinary returns the CRC-64 checksum of the binary representations of data
+// in the given byte order, as written by [binary.Write], without buffering them.
+// Each value must be a fixed-size value or a slice of fixed-size values, or a pointer
+// to such data, otherwise an error is returned.
+func (p *Poly) ChecksumBinary(order binary.ByteOrder, data ...any) (uint64, error) {
+	h := p.Hasher()
+	for _, v := range data {
+		if err := binary.Write(&h, order, v); err != nil {
+			return 0, err
+		}
+	}
+	return h.Sum64(), nil
+}
+
+// Writer computes a CRC-64 checksum of fixed-size values encoded in big-endian
+// byte order, as if by [binary.Write], without requiring a scratch buffer.
+// A Writer must be created by [Poly.Writer].
+type Writer struct {
+	poly *Poly
+	sum  uint64
+}
+
+// Writer returns a new [Writer] computing the CRC-64 checksum using the polynomial
+// represented by the [Poly].
+func (p *Poly) Writer() Writer {
+	return Writer{poly: p}
+}
+
+// WriteByte adds b to the running checksum. It never returns an error.
+func (w *Writer) WriteByte(b byte) error {
+	buf := [1]byte{b}
+	w.sum = w.poly.Update(w.sum, buf[:])
+	return nil
+}
+
+// WriteBytes adds the bytes in b to the running checksum.
+func (w *Writer) WriteBytes(b []byte) {
+	w.sum = w.poly.Update(w.sum, b)
+}
+
+// WriteUint16BE adds the big-endian encoding of v to the running checksum.
+func (w *Writer) WriteUint16BE(v uint16) {
+	var buf [2]byte
+	binary.BigEndian.PutUint16(buf[:], v)
+	w.sum = w.poly.Update(w.sum, buf[:])
+}
+
+// WriteUint32BE adds the big-endian encoding of v to the running checksum.
+func (w *Writer) WriteUint32BE(v uint32) {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], v)
+	w.sum = w.poly.Update(w.sum, buf[:])
+}
+
+// WriteUint64BE adds the big-endian encoding of v to the running checksum.
+func (w *Writer) WriteUint64BE(v uint64) {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+	w.sum = w.poly.Update(w.sum, buf[:])
+}
+
+// Sum64 returns the running checksum.
+func (w *Writer) Sum64() uint64 {
+	return w.sum
+}
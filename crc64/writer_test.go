@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc64
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteByte(0x7f)
+	binary.Write(&buf, binary.BigEndian, uint16(0x1234))
+	binary.Write(&buf, binary.BigEndian, uint32(0xdeadbeef))
+	binary.Write(&buf, binary.BigEndian, uint64(0x0123456789abcdef))
+	buf.WriteString("payload")
+
+	for _, p := range polys {
+		w := p.Writer()
+		w.WriteByte(0x7f)
+		w.WriteUint16BE(0x1234)
+		w.WriteUint32BE(0xdeadbeef)
+		w.WriteUint64BE(0x0123456789abcdef)
+		w.WriteBytes([]byte("payload"))
+		if got, want := w.Sum64(), p.Checksum(buf.Bytes()); got != want {
+			t.Errorf("Poly = 0x%016x; Writer.Sum64() = 0x%016x; want 0x%016x", p.poly, got, want)
+		}
+	}
+}
+
+func TestChecksumBinary(t *testing.T) {
+	type header struct {
+		Magic   [4]byte
+		Version uint16
+		Flags   int8
+		Scale   float64
+	}
+	data := []any{
+		uint32(0xdeadbeef),
+		int64(-42),
+		float32(1.5),
+		[3]uint16{1, 2, 3},
+		[]int32{-1, 0, 1},
+		&header{Magic: [4]byte{'C', 'R', 'C', '!'}, Version: 2, Flags: -1, Scale: 0.25},
+		true,
+	}
+	for _, order := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+		var buf bytes.Buffer
+		for _, v := range data {
+			if err := binary.Write(&buf, order, v); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, p := range polys {
+			got, err := p.ChecksumBinary(order, data...)
+			if err != nil {
+				t.Fatalf("Poly = 0x%016x; ChecksumBinary(%v) failed: %v", p.poly, order, err)
+			}
+			if want := p.Checksum(buf.Bytes()); got != want {
+				t.Errorf("Poly = 0x%016x; ChecksumBinary(%v) = 0x%016x; want 0x%016x", p.poly, order, got, want)
+			}
+		}
+	}
+	for _, v := range []any{"string", 42, []any{uint8(1)}, map[int]int{}} {
+		if _, err := polys[0].ChecksumBinary(binary.BigEndian, v); err == nil {
+			t.Errorf("ChecksumBinary(%T) succeeded; want error", v)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+// Package crc8 implements the 8-bit cyclic redundancy check, or CRC-8, checksum.
+// See https://en.wikipedia.org/wiki/Cyclic_redundancy_check for information.
+//
+// Polynomials are represented in LSB-first form, also known as reversed representation.
+// Like the crc32 and crc64 packages, sums are inverted before and after they're updated.
+package crc8
+
+import (
+	"encoding"
+	"errors"
+	"hash"
+	"sync"
+
+	"bursavich.dev/crc/internal/lazy"
+)
+
+// The size of a CRC-8 checksum in bytes.
+const Size = 1
+
+// Predefined polynomials in LSB-first form.
+const (
+	ROHCPoly  = 0xe0
+	MaximPoly = 0x8c
+)
+
+var rohcPoly = lazy.Value[*Poly]{
+	Init: func() *Poly {
+		return makePoly(ROHCPoly)
+	},
+}
+
+// ROHC returns the [Poly] representing the polynomial x^8 + x^2 + x + 1, which is 0x07
+// in normal form, as used by RObust Header Compression (RFC 3095). ROHC doesn't invert
+// the final sum, so its checksum is the inverse of the [Poly]'s. The same polynomial is
+// used unreflected by SMBus and the ATM HEC, which are computed by [ChecksumSMBus] and
+// [ChecksumATM].
+func ROHC() *Poly {
+	return rohcPoly.Get()
+}
+
+var maximPoly = lazy.Value[*Poly]{
+	Init: func() *Poly {
+		return makePoly(MaximPoly)
+	},
+}
+
+// Maxim returns the [Poly] representing the Maxim polynomial, x^8 + x^5 + x^4 + 1,
+// which is 0x31 in normal form. It's used by Dallas/Maxim 1-Wire devices.
+func Maxim() *Poly {
+	return maximPoly.Get()
+}
+
+// Hash is a [hash.Hash] that also implements [encoding.BinaryMarshaler]
+// and [encoding.BinaryUnmarshaler] to marshal and unmarshal the internal state
+// of the hash. Its Sum method will lay the value out in big-endian byte order.
+type Hash interface {
+	hash.Hash
+	Sum8() uint8
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+// New creates a new [Hash] computing the CRC-8 checksum using the polynomial
+// represented by the [Poly].
+func New(p *Poly) Hash {
+	return &digest{poly: p}
+}
+
+const nBits = Size * 8
+
+// Poly represents a 8-bit polynomial with tables for efficient processing.
+type Poly struct {
+	poly   uint8
+	x2nTbl [nBits]uint8
+	table  [256]uint8
+}
+
+// MakePoly returns a [Poly] constructed from the specified polynomial
+// given in LSB-first form, also known as reversed representation.
+// The returned [Poly] may be shared and must not be modified. Polys are cached
+// for the life of the process, so each polynomial's tables are computed once.
+func MakePoly(poly uint8) *Poly {
+	switch poly {
+	case ROHCPoly:
+		return ROHC()
+	case MaximPoly:
+		return Maxim()
+	default:
+		return cachedPoly(poly)
+	}
+}
+
+// polyCache holds the [Poly] of each polynomial that isn't named, once made,
+// so that it's shared like the named ones. It's a map[uint8]*Poly.
+var polyCache sync.Map
+
+func cachedPoly(poly uint8) *Poly {
+	if p, ok := polyCache.Load(poly); ok {
+		return p.(*Poly)
+	}
+	p, _ := polyCache.LoadOrStore(poly, makePoly(poly))
+	return p.(*Poly)
+}
+
+func makePoly(poly uint8) *Poly {
+	p := &Poly{poly: poly}
+	for i := range p.table {
+		crc := uint8(i)
+		for range 8 {
+			if crc&1 == 1 {
+				crc = (crc >> 1) ^ poly
+			} else {
+				crc >>= 1
+			}
+		}
+		p.table[i] = crc
+	}
+	v := uint8(1) << (nBits - 2)
+	p.x2nTbl[0] = v
+	for n := 1; n < nBits; n++ {
+		v = p.multModP(v, v)
+		p.x2nTbl[n] = v
+	}
+	return p
+}
+
+// Polynomial returns the polynomial in LSB-first form, also known as reversed representation.
+func (p *Poly) Polynomial() uint8 {
+	return p.poly
+}
+
+// Checksum returns the CRC-8 checksum of data.
+func (p *Poly) Checksum(data []byte) uint8 {
+	return p.Update(0, data)
+}
+
+// Update returns the result of adding the bytes in data to the sum.
+func (p *Poly) Update(sum uint8, data []byte) uint8 {
+	crc := ^sum
+	for _, v := range data {
+		crc = p.table[crc^v]
+	}
+	return ^crc
+}
+
+// Combine returns the result of adding n bytes with the next sum to the prev sum.
+func (p *Poly) Combine(prev, next uint8, n int64) uint8 {
+	if prev == 0 {
+		return next
+	}
+	if n <= 0 {
+		return prev
+	}
+	return p.multModP(prev, p.x2NModP(n, 3)) ^ next
+}
+
+// ExtendZeros returns the result of adding n zero bytes to the sum, as if by [Poly.Update]
+// with a buffer of n zeros, in time logarithmic in n. It's useful for the holes of sparse
+// files. Note that it differs from combining with a zero next sum, which is the sum of no
+// bytes rather than of n zeros. It returns the sum unchanged if n isn't positive.
+func (p *Poly) ExtendZeros(sum uint8, n int64) uint8 {
+	if n <= 0 {
+		return sum
+	}
+	return ^p.multModP(p.x2NModP(n, 3), ^sum)
+}
+
+// multModP returns (a(x) * b(x)) modulo p(x), where p(x) is the CRC polynomial, reflected.
+// For speed, this requires that a is not zero.
+func (p *Poly) multModP(a, b uint8) uint8 {
+	var v uint8
+	for m := uint8(1) << (nBits - 1); m != 0; m >>= 1 {
+		if a&m != 0 {
+			if v ^= b; a&(m-1) == 0 {
+				return v
+			}
+		}
+		xor := b&1 != 0
+		if b >>= 1; xor {
+			b ^= p.poly
+		}
+	}
+	panic("crc8: invalid state")
+}
+
+// x2NModP returns x^(n * 2^k) modulo p(x).
+func (p *Poly) x2NModP(n int64, k uint32) uint8 {
+	v := uint8(1) << (nBits - 1)
+	var sq uint8
+	for ; n != 0; n, k = n>>1, k+1 {
+		// The table is too short for the powers of x to repeat within it,
+		// so larger powers are found by squaring.
+		if k < nBits {
+			sq = p.x2nTbl[k]
+		} else {
+			sq = p.multModP(sq, sq)
+		}
+		if n&1 != 0 {
+			v = p.multModP(sq, v)
+		}
+	}
+	return v
+}
+
+// The marshaled state is laid out as a magic identifier,
+// the polynomial, and the current sum.
+const (
+	magic         = "crc8\x01"
+	marshaledSize = len(magic) + 2*Size
+)
+
+var (
+	// ErrInvalidState is returned when unmarshaling hash state
+	// that is malformed or truncated.
+	ErrInvalidState = errors.New("crc8: invalid hash state")
+
+	// ErrStateMismatch is returned when unmarshaling hash state
+	// that was marshaled by a [Hash] using a different polynomial.
+	ErrStateMismatch = errors.New("crc8: hash state polynomial mismatch")
+)
+
+type digest struct {
+	poly *Poly
+	crc  uint8
+}
+
+func (d *digest) Size() int { return Size }
+
+func (d *digest) BlockSize() int { return 1 }
+
+func (d *digest) Reset() { d.crc = 0 }
+
+func (d *digest) Write(p []byte) (int, error) {
+	d.crc = d.poly.Update(d.crc, p)
+	return len(p), nil
+}
+
+func (d *digest) Sum8() uint8 { return d.crc }
+
+func (d *digest) Sum(b []byte) []byte {
+	return append(b, d.crc)
+}
+
+func (d *digest) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, marshaledSize)
+	b = append(b, magic...)
+	b = append(b, d.poly.poly)
+	b = append(b, d.crc)
+	return b, nil
+}
+
+func (d *digest) UnmarshalBinary(b []byte) error {
+	if len(b) < len(magic) || string(b[:len(magic)]) != magic {
+		return ErrInvalidState
+	}
+	if len(b) != marshaledSize {
+		return ErrInvalidState
+	}
+	i := len(magic)
+	if b[i] != d.poly.poly {
+		return ErrStateMismatch
+	}
+	i += Size
+	d.crc = b[i]
+	return nil
+}
@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc8
+
+// ChecksumMaxim returns the CRC-8 checksum of data as computed by Dallas/Maxim 1-Wire
+// devices, which use the [Maxim] polynomial but neither invert the initial nor the
+// final sum.
+func ChecksumMaxim(data []byte) uint8 {
+	return ^Maxim().Update(^uint8(0), data)
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Zlib
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by the zlib license
+// which can be found in the LICENSE file.
+
+package crc8
+
+var (
+	smbusModel = Model{Width: nBits, Poly: 0x07}
+	atmModel   = Model{Width: nBits, Poly: 0x07, XorOut: 0x55}
+)
+
+// ChecksumSMBus returns the CRC-8 checksum of data as computed by the SMBus packet
+// error code, which processes bits most significant first and neither inverts the
+// initial nor the final sum.
+func ChecksumSMBus(data []byte) uint8 {
+	return smbusModel.Checksum(data)
+}
+
+// ChecksumATM returns the CRC-8 checksum of data as computed by the ATM header error
+// control (ITU-T I.432.1), which is like [ChecksumSMBus] but XORs the final sum with 0x55.
+func ChecksumATM(data []byte) uint8 {
+	return atmModel.Checksum(data)
+}
@@ -1,5 +1,12 @@
 module bursavich.dev/crc
 
-go 1.22
+go 1.23
 
-toolchain go1.22.2
+toolchain go1.23.2
+
+require (
+	golang.org/x/sys v0.26.0
+	golang.org/x/text v0.16.0
+	golang.org/x/time v0.7.0
+	google.golang.org/protobuf v1.34.2
+)
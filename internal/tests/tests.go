@@ -53,3 +53,49 @@ func TestPoly(t *testing.T, fn PolyFunc) {
 		})
 	}
 }
+
+// Sum is the type of a checksum of any width.
+type Sum interface {
+	~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Width describes a polynomial of a CRC package of a particular width,
+// so that invariants shared by every width may be tested uniformly.
+type Width[T Sum] struct {
+	Bits  int // width of the checksum in bits
+	Size  int // size of the checksum in bytes
+	Check T   // published checksum of "123456789", not one computed by the package
+
+	Checksum    func(data []byte) T
+	Update      func(sum T, data []byte) T
+	Combine     func(prev, next T, n int64) T
+	ExtendZeros func(sum T, n int64) T
+}
+
+// TestWidth tests that the invariants shared by every width hold for w.
+func TestWidth[T Sum](t *testing.T, w Width[T]) {
+	if w.Bits != 8*w.Size {
+		t.Fatalf("Bits = %d; want 8*Size = %d", w.Bits, 8*w.Size)
+	}
+	if got := w.Checksum([]byte("123456789")); got != w.Check {
+		t.Errorf("Checksum(\"123456789\") = %#x; want %#x", got, w.Check)
+	}
+	empty := w.Checksum(nil)
+	for _, c := range polyCases {
+		aSum, bSum := w.Checksum(c.a), w.Checksum(c.b)
+		want := w.Checksum(append(c.a[:len(c.a):len(c.a)], c.b...))
+		if got := w.Combine(aSum, bSum, int64(len(c.b))); got != want {
+			t.Errorf("Combine(%#x, %#x, %d) = %#x; want %#x", aSum, bSum, len(c.b), got, want)
+		}
+		if got := w.Combine(aSum, empty, 0); got != aSum {
+			t.Errorf("Combine(%#x, %#x, 0) = %#x; want %#x", aSum, empty, got, aSum)
+		}
+		if got := w.Combine(empty, bSum, int64(len(c.b))); got != bSum {
+			t.Errorf("Combine(%#x, %#x, %d) = %#x; want %#x", empty, bSum, len(c.b), got, bSum)
+		}
+		n := int64(len(c.b) + w.Size)
+		if got, want := w.ExtendZeros(aSum, n), w.Update(aSum, make([]byte, n)); got != want {
+			t.Errorf("ExtendZeros(%#x, %d) = %#x; want %#x", aSum, n, got, want)
+		}
+	}
+}